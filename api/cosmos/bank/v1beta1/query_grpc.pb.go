@@ -40,6 +40,16 @@ type QueryClient interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error)
+	// FactoryDenom queries the admin of a token factory denom. As factory denoms
+	// contain slashes, the denom is passed as a query parameter.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsFromCreator queries all the token factory denoms created by
+	// an account.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenomsFromCreator(ctx context.Context, in *QueryFactoryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsFromCreatorResponse, error)
 }
 
 type queryClient struct {
@@ -122,6 +132,24 @@ func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersReque
 	return out, nil
 }
 
+func (c *queryClient) FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error) {
+	out := new(QueryFactoryDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/FactoryDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenomsFromCreator(ctx context.Context, in *QueryFactoryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsFromCreatorResponse, error) {
+	out := new(QueryFactoryDenomsFromCreatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/FactoryDenomsFromCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -144,6 +172,16 @@ type QueryServer interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error)
+	// FactoryDenom queries the admin of a token factory denom. As factory denoms
+	// contain slashes, the denom is passed as a query parameter.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsFromCreator queries all the token factory denoms created by
+	// an account.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenomsFromCreator(context.Context, *QueryFactoryDenomsFromCreatorRequest) (*QueryFactoryDenomsFromCreatorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -175,6 +213,12 @@ func (UnimplementedQueryServer) DenomsMetadata(context.Context, *QueryDenomsMeta
 func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
+func (UnimplementedQueryServer) FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenom not implemented")
+}
+func (UnimplementedQueryServer) FactoryDenomsFromCreator(context.Context, *QueryFactoryDenomsFromCreatorRequest) (*QueryFactoryDenomsFromCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenomsFromCreator not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -332,6 +376,42 @@ func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FactoryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/FactoryDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenom(ctx, req.(*QueryFactoryDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomsFromCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenomsFromCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/FactoryDenomsFromCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenomsFromCreator(ctx, req.(*QueryFactoryDenomsFromCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -371,6 +451,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
 		},
+		{
+			MethodName: "FactoryDenom",
+			Handler:    _Query_FactoryDenom_Handler,
+		},
+		{
+			MethodName: "FactoryDenomsFromCreator",
+			Handler:    _Query_FactoryDenomsFromCreator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
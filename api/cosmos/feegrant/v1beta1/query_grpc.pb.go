@@ -29,6 +29,15 @@ type QueryClient interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// SponsorPool returns the sponsor pool of a granter.
+	//
+	// Since: cosmos-sdk 0.46
+	SponsorPool(ctx context.Context, in *QuerySponsorPoolRequest, opts ...grpc.CallOption) (*QuerySponsorPoolResponse, error)
+	// SponsorPoolUsage returns the usage of a granter's sponsor pool by a fee payer
+	// in the current period.
+	//
+	// Since: cosmos-sdk 0.46
+	SponsorPoolUsage(ctx context.Context, in *QuerySponsorPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorPoolUsageResponse, error)
 }
 
 type queryClient struct {
@@ -66,6 +75,24 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) SponsorPool(ctx context.Context, in *QuerySponsorPoolRequest, opts ...grpc.CallOption) (*QuerySponsorPoolResponse, error) {
+	out := new(QuerySponsorPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SponsorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorPoolUsage(ctx context.Context, in *QuerySponsorPoolUsageRequest, opts ...grpc.CallOption) (*QuerySponsorPoolUsageResponse, error) {
+	out := new(QuerySponsorPoolUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SponsorPoolUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -77,6 +104,15 @@ type QueryServer interface {
 	// AllowancesByGranter returns all the grants given by an address
 	// Since v0.46
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// SponsorPool returns the sponsor pool of a granter.
+	//
+	// Since: cosmos-sdk 0.46
+	SponsorPool(context.Context, *QuerySponsorPoolRequest) (*QuerySponsorPoolResponse, error)
+	// SponsorPoolUsage returns the usage of a granter's sponsor pool by a fee payer
+	// in the current period.
+	//
+	// Since: cosmos-sdk 0.46
+	SponsorPoolUsage(context.Context, *QuerySponsorPoolUsageRequest) (*QuerySponsorPoolUsageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -93,6 +129,12 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) SponsorPool(context.Context, *QuerySponsorPoolRequest) (*QuerySponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorPool not implemented")
+}
+func (UnimplementedQueryServer) SponsorPoolUsage(context.Context, *QuerySponsorPoolUsageRequest) (*QuerySponsorPoolUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorPoolUsage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -160,6 +202,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SponsorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorPool(ctx, req.(*QuerySponsorPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorPoolUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorPoolUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorPoolUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SponsorPoolUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorPoolUsage(ctx, req.(*QuerySponsorPoolUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -179,6 +257,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "SponsorPool",
+			Handler:    _Query_SponsorPool_Handler,
+		},
+		{
+			MethodName: "SponsorPoolUsage",
+			Handler:    _Query_SponsorPoolUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -28,6 +28,15 @@ type MsgClient interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance, opts ...grpc.CallOption) (*MsgRevokeAllowanceResponse, error)
+	// CreateSponsorPool creates a sponsor pool paying fees from the granter's
+	// account for any fee payer sending only allowed messages.
+	//
+	// Since: cosmos-sdk 0.46
+	CreateSponsorPool(ctx context.Context, in *MsgCreateSponsorPool, opts ...grpc.CallOption) (*MsgCreateSponsorPoolResponse, error)
+	// RevokeSponsorPool revokes the sponsor pool of the granter's account.
+	//
+	// Since: cosmos-sdk 0.46
+	RevokeSponsorPool(ctx context.Context, in *MsgRevokeSponsorPool, opts ...grpc.CallOption) (*MsgRevokeSponsorPoolResponse, error)
 }
 
 type msgClient struct {
@@ -56,6 +65,24 @@ func (c *msgClient) RevokeAllowance(ctx context.Context, in *MsgRevokeAllowance,
 	return out, nil
 }
 
+func (c *msgClient) CreateSponsorPool(ctx context.Context, in *MsgCreateSponsorPool, opts ...grpc.CallOption) (*MsgCreateSponsorPoolResponse, error) {
+	out := new(MsgCreateSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/CreateSponsorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSponsorPool(ctx context.Context, in *MsgRevokeSponsorPool, opts ...grpc.CallOption) (*MsgRevokeSponsorPoolResponse, error) {
+	out := new(MsgRevokeSponsorPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Msg/RevokeSponsorPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -66,6 +93,15 @@ type MsgServer interface {
 	// RevokeAllowance revokes any fee allowance of granter's account that
 	// has been granted to the grantee.
 	RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error)
+	// CreateSponsorPool creates a sponsor pool paying fees from the granter's
+	// account for any fee payer sending only allowed messages.
+	//
+	// Since: cosmos-sdk 0.46
+	CreateSponsorPool(context.Context, *MsgCreateSponsorPool) (*MsgCreateSponsorPoolResponse, error)
+	// RevokeSponsorPool revokes the sponsor pool of the granter's account.
+	//
+	// Since: cosmos-sdk 0.46
+	RevokeSponsorPool(context.Context, *MsgRevokeSponsorPool) (*MsgRevokeSponsorPoolResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -79,6 +115,12 @@ func (UnimplementedMsgServer) GrantAllowance(context.Context, *MsgGrantAllowance
 func (UnimplementedMsgServer) RevokeAllowance(context.Context, *MsgRevokeAllowance) (*MsgRevokeAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllowance not implemented")
 }
+func (UnimplementedMsgServer) CreateSponsorPool(context.Context, *MsgCreateSponsorPool) (*MsgCreateSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSponsorPool not implemented")
+}
+func (UnimplementedMsgServer) RevokeSponsorPool(context.Context, *MsgRevokeSponsorPool) (*MsgRevokeSponsorPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSponsorPool not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -128,6 +170,42 @@ func _Msg_RevokeAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/CreateSponsorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSponsorPool(ctx, req.(*MsgCreateSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSponsorPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSponsorPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSponsorPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Msg/RevokeSponsorPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSponsorPool(ctx, req.(*MsgRevokeSponsorPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -143,6 +221,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeAllowance",
 			Handler:    _Msg_RevokeAllowance_Handler,
 		},
+		{
+			MethodName: "CreateSponsorPool",
+			Handler:    _Msg_CreateSponsorPool_Handler,
+		},
+		{
+			MethodName: "RevokeSponsorPool",
+			Handler:    _Msg_RevokeSponsorPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/tx.proto",
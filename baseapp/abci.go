@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -172,7 +174,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	app.deliverState.ctx = app.deliverState.ctx.
 		WithBlockGasMeter(gasMeter).
 		WithHeaderHash(req.Hash).
-		WithConsensusParams(app.GetConsensusParams(app.deliverState.ctx))
+		WithConsensusParams(app.GetConsensusParams(app.deliverState.ctx)).
+		WithGasSchedule(app.GetGasSchedule(app.deliverState.ctx))
 
 	// we also set block gas meter to checkState in case the application needs to
 	// verify gas consumption during (Re)CheckTx
@@ -193,6 +196,9 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
 			app.logger.Error("BeginBlock listening hook failed", "height", req.Header.Height, "err", err)
+			if app.stopNodeOnStreamingErr {
+				panic(err)
+			}
 		}
 	}
 
@@ -219,6 +225,9 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	for _, streamingListener := range app.abciListeners {
 		if err := streamingListener.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
 			app.logger.Error("EndBlock listening hook failed", "height", req.Height, "err", err)
+			if app.stopNodeOnStreamingErr {
+				panic(err)
+			}
 		}
 	}
 
@@ -269,27 +278,46 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 
 	var abciRes abci.ResponseDeliverTx
 	defer func() {
-		for _, streamingListener := range app.abciListeners {
-			if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, abciRes); err != nil {
-				app.logger.Error("DeliverTx listening hook failed", "err", err)
-			}
-		}
+		app.listenDeliverTx(req, abciRes)
 	}()
 
-	ctx := app.getContextForTx(runTxModeDeliver, req.Tx)
-	res, err := app.txHandler.DeliverTx(ctx, tx.Request{TxBytes: req.Tx})
-	if err != nil {
-		abciRes = sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
+	if !app.tracingAccesses() {
+		abciRes = app.deliverTx(app.getContextForTx(runTxModeDeliver, req.Tx), req.Tx)
 		return abciRes
 	}
 
-	abciRes, err = convertTxResponseToDeliverTx(res)
+	ms := accesskv.NewMultiStore(app.deliverState.ms)
+	abciRes = app.deliverTx(app.newTxContext(app.deliverState.ctx.WithMultiStore(ms), runTxModeDeliver, req.Tx), req.Tx)
+	ms.Write()
+	app.recordAccesses(req.Tx, ms)
+	return abciRes
+}
+
+// deliverTx runs a tx in DeliverTx mode with the given context.
+func (app *BaseApp) deliverTx(ctx context.Context, txBytes []byte) abci.ResponseDeliverTx {
+	res, err := app.txHandler.DeliverTx(ctx, tx.Request{TxBytes: txBytes})
+	if err != nil {
+		return sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
+	}
+
+	abciRes, err := convertTxResponseToDeliverTx(res)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
 	}
 
 	return abciRes
+}
 
+// listenDeliverTx passes a delivered tx to the ABCI listeners.
+func (app *BaseApp) listenDeliverTx(req abci.RequestDeliverTx, res abci.ResponseDeliverTx) {
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenDeliverTx(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("DeliverTx listening hook failed", "err", err)
+			if app.stopNodeOnStreamingErr {
+				panic(err)
+			}
+		}
+	}
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -307,10 +335,36 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
+	//
+	// The listeners of the streaming services collect the state changes written
+	// to the root MultiStore, i.e. the changes committed in the block.
+	for _, streamingListener := range app.abciListeners {
+		streamingListener.changeSet.committing = true
+	}
 	app.deliverState.ms.Write()
+	for _, streamingListener := range app.abciListeners {
+		streamingListener.changeSet.committing = false
+	}
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	res = abci.ResponseCommit{
+		Data:         commitID.Hash,
+		RetainHeight: retainHeight,
+	}
+
+	// call the streaming service hooks with the Commit messages
+	for _, streamingListener := range app.abciListeners {
+		if err := streamingListener.ListenCommit(app.deliverState.ctx, res, streamingListener.changeSet.pop()); err != nil {
+			app.logger.Error("Commit listening hook failed", "height", header.Height, "err", err)
+			if app.stopNodeOnStreamingErr {
+				panic(err)
+			}
+		}
+	}
+
+	app.commitAccesses(header.Height)
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -342,10 +396,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		go app.snapshot(header.Height)
 	}
 
-	return abci.ResponseCommit{
-		Data:         commitID.Hash,
-		RetainHeight: retainHeight,
-	}
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -400,8 +451,8 @@ func (app *BaseApp) snapshot(height int64) {
 	}
 }
 
-// Query implements the ABCI interface. It delegates to CommitMultiStore if it
-// implements Queryable.
+// Query implements the ABCI interface. It delegates to CommitMultiStore, or the
+// QueryMultiStore if one is set, if it implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 
 	// Add panic recovery for all queries.
@@ -414,7 +465,7 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 
 	// when a client did not provide a query height, manually inject the latest
 	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
+		req.Height = app.lastQueryHeight()
 	}
 
 	// handle gRPC routes first rather than calling splitPath because '/' characters
@@ -508,7 +559,7 @@ func (app *BaseApp) OfferSnapshot(req abci.RequestOfferSnapshot) abci.ResponseOf
 		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_REJECT}
 	}
 
-	err = app.snapshotManager.Restore(snapshot)
+	err = app.snapshotManager.Restore(snapshot, req.AppHash)
 	switch {
 	case err == nil:
 		return abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}
@@ -626,7 +677,7 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 
 	// when a client did not provide a query height, manually inject the latest
 	if height == 0 {
-		height = app.LastBlockHeight()
+		height = app.lastQueryHeight()
 	}
 
 	if height <= 1 && prove {
@@ -637,12 +688,12 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	cacheMS, err := app.queryMultiStore().CacheMultiStoreWithVersion(height)
 	if err != nil {
 		return sdk.Context{},
 			sdkerrors.Wrapf(
 				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
+				"failed to load state at height %d; %s (latest height: %d)", height, err, app.lastQueryHeight(),
 			)
 	}
 
@@ -789,7 +840,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	// "/store" prefix for store queries
-	queryable, ok := app.cms.(sdk.Queryable)
+	queryable, ok := app.queryMultiStore().(sdk.Queryable)
 	if !ok {
 		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't support queries"), app.trace)
 	}
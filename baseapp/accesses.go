@@ -0,0 +1,79 @@
+package baseapp
+
+import (
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SimulateWithAccesses executes a tx in simulate mode to get result and gas info, along with the
+// keys of each store it accessed, ordered by store key name.
+func (app *BaseApp) SimulateWithAccesses(txBytes []byte) (sdk.GasInfo, *sdk.Result, []*storetypes.StoreAccesses, error) {
+	// The simulation runs directly on the recording store rather than on a branch of it, and the
+	// recording store branches a branch of the check state, which is discarded.
+	ms := accesskv.NewMultiStore(app.checkState.CacheMultiStore())
+	ctx := app.newTxContext(app.checkState.ctx.WithMultiStore(ms), runTxModeCheck, txBytes)
+	gInfo, res, err := app.simulate(ctx, txBytes)
+
+	// Writes are recorded once the recording store is written to its parent.
+	ms.Write()
+
+	return gInfo, res, ms.StoreAccesses(), err
+}
+
+// BlockAccesses returns the keys of each store accessed by the txs delivered at a height, in the
+// order they were delivered. Accesses are only kept for recent heights, while access tracing is
+// enabled with SetAccessTracing.
+func (app *BaseApp) BlockAccesses(height int64) ([]*storetypes.TxAccesses, error) {
+	if app.accessTracingBlocks == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "access tracing is disabled")
+	}
+
+	app.blockAccessesMtx.RLock()
+	defer app.blockAccessesMtx.RUnlock()
+
+	txs, ok := app.blockAccesses[height]
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no accesses recorded at height %d", height)
+	}
+	return txs, nil
+}
+
+// tracingAccesses returns whether the accesses of delivered txs are recorded. Accesses are not
+// recorded while tracing is enabled, as the multi-store recording them does not support tracing.
+func (app *BaseApp) tracingAccesses() bool {
+	return app.accessTracingBlocks > 0 && !app.deliverState.ms.TracingEnabled()
+}
+
+// recordAccesses records the accesses of a tx delivered in the current block.
+func (app *BaseApp) recordAccesses(txBytes []byte, ms *accesskv.MultiStore) {
+	height := app.deliverState.ctx.BlockHeight()
+	txAccesses := &storetypes.TxAccesses{TxHash: tmtypes.Tx(txBytes).Hash(), Stores: ms.StoreAccesses()}
+
+	app.blockAccessesMtx.Lock()
+	defer app.blockAccessesMtx.Unlock()
+	app.blockAccesses[height] = append(app.blockAccesses[height], txAccesses)
+}
+
+// commitAccesses marks the accesses of a block as complete, and prunes those of blocks which are
+// no longer kept.
+func (app *BaseApp) commitAccesses(height int64) {
+	if app.accessTracingBlocks == 0 {
+		return
+	}
+
+	app.blockAccessesMtx.Lock()
+	defer app.blockAccessesMtx.Unlock()
+
+	if _, ok := app.blockAccesses[height]; !ok {
+		app.blockAccesses[height] = []*storetypes.TxAccesses{}
+	}
+	for h := range app.blockAccesses {
+		if h <= height-int64(app.accessTracingBlocks) {
+			delete(app.blockAccesses, h)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -16,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
@@ -48,6 +50,7 @@ type BaseApp struct { // nolint: maligned
 	name              string               // application name from abci.Info
 	db                dbm.DB               // common DB backend
 	cms               sdk.CommitMultiStore // Main (uncached) state
+	qms               sdk.QueryMultiStore  // state to serve queries from, if not cms
 	storeLoader       StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
 	queryRouter       sdk.QueryRouter      // router for redirecting query calls
 	grpcQueryRouter   *GRPCQueryRouter     // router for redirecting gRPC query calls
@@ -83,6 +86,10 @@ type BaseApp struct { // nolint: maligned
 	// application parameter store.
 	paramStore ParamStore
 
+	// gasSchedule defines the gas configs registered for KVStores, which are
+	// overridden by the gas schedule of the paramStore.
+	gasSchedule storetypes.GasSchedule
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -127,7 +134,24 @@ type BaseApp struct { // nolint: maligned
 
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
-	abciListeners []ABCIListener
+	abciListeners []streamingListener
+
+	// stopNodeOnStreamingErr halts the node when an abciListener returns an error
+	stopNodeOnStreamingErr bool
+
+	// parallelTxWorkers is the number of goroutines DeliverTxs executes a block's
+	// txs with. Values less than 2 disable parallel execution.
+	parallelTxWorkers int
+
+	// accessTracingBlocks is the number of recent blocks for which the accesses of
+	// each delivered tx are kept. Zero disables access tracing.
+	accessTracingBlocks uint64
+	// blockAccesses are the accesses of the txs delivered at each recent height
+	blockAccesses    map[int64][]*storetypes.TxAccesses
+	blockAccessesMtx sync.RWMutex
+
+	// mempool is the app-side mempool block proposals are selected from
+	mempool mempool.Mempool
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -185,6 +209,12 @@ func (app *BaseApp) Trace() bool {
 	return app.trace
 }
 
+// SnapshotManager returns the snapshot manager, or nil if no snapshot store is set. Apps can use
+// it to register extension snapshotters.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...storetypes.StoreKey) {
@@ -281,6 +311,19 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// queryMultiStore returns the multistore queries are served from.
+func (app *BaseApp) queryMultiStore() sdk.QueryMultiStore {
+	if app.qms != nil {
+		return app.qms
+	}
+	return app.cms
+}
+
+// lastQueryHeight returns the latest height queries can be served at.
+func (app *BaseApp) lastQueryHeight() int64 {
+	return app.queryMultiStore().LastCommitID().Version
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -405,6 +448,20 @@ func (app *BaseApp) GetConsensusParams(ctx sdk.Context) *tmproto.ConsensusParams
 	return cp
 }
 
+// GetGasSchedule returns the gas configs KVStores are accessed with: the gas configs
+// registered with RegisterStoreGasConfig, overridden by the gas schedule of the
+// BaseApp's ParamStore.
+func (app *BaseApp) GetGasSchedule(ctx sdk.Context) storetypes.GasSchedule {
+	if app.paramStore == nil || !app.paramStore.Has(ctx, ParamStoreKeyGasSchedule) {
+		return app.gasSchedule
+	}
+
+	var gs storetypes.GasSchedule
+	app.paramStore.Get(ctx, ParamStoreKeyGasSchedule, &gs)
+
+	return app.gasSchedule.Override(gs)
+}
+
 // StoreConsensusParams sets the consensus parameters to the baseapp's param store.
 func (app *BaseApp) StoreConsensusParams(ctx sdk.Context, cp *tmproto.ConsensusParams) {
 	if app.paramStore == nil {
@@ -484,11 +541,20 @@ func (app *BaseApp) getState(mode runTxMode) *state {
 
 // retrieve the context for the tx w/ txBytes and other memoized values.
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) context.Context {
-	ctx := app.getState(mode).ctx.
+	return app.newTxContext(app.getState(mode).ctx, mode, txBytes)
+}
+
+// newTxContext returns a context for running a tx from the context of a state.
+// Each tx emits events to its own EventManager.
+func (app *BaseApp) newTxContext(ctx sdk.Context, mode runTxMode, txBytes []byte) context.Context {
+	ctx = ctx.
 		WithTxBytes(txBytes).
-		WithVoteInfos(app.voteInfos)
+		WithVoteInfos(app.voteInfos).
+		WithEventManager(sdk.NewEventManager())
 
-	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	ctx = ctx.
+		WithConsensusParams(app.GetConsensusParams(ctx)).
+		WithGasSchedule(app.GetGasSchedule(ctx))
 
 	if mode == runTxModeReCheck {
 		ctx = ctx.WithIsReCheckTx(true)
@@ -20,6 +20,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -606,6 +607,15 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetFauxMerkleMode()
 	})
+	require.Panics(t, func() {
+		app.SetParallelTxExecution(4)
+	})
+	require.Panics(t, func() {
+		app.RegisterStoreGasConfig(capKey1, storetypes.KVGasConfig())
+	})
+	require.Panics(t, func() {
+		app.SetMempool(nil)
+	})
 }
 
 func TestSetMinGasPrices(t *testing.T) {
@@ -1099,6 +1109,197 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// appendKeyValueTxHandlerOpt sets a tx handler which appends the value of each msgKeyValue to its
+// key, counting the keys of the shared prefix for shared keys.
+func appendKeyValueTxHandlerOpt(bapp *baseapp.BaseApp) {
+	legacyRouter := middleware.NewLegacyRouter()
+	legacyRouter.AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		kv := msg.(*msgKeyValue)
+		if bytes.Equal(kv.Value, []byte("fail")) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+		}
+		store := ctx.KVStore(capKey2)
+		value := append(append([]byte{}, store.Get(kv.Key)...), kv.Value...)
+		store.Set(kv.Key, value)
+
+		count := 0
+		if bytes.HasPrefix(kv.Key, []byte("shared/")) {
+			iter := sdk.KVStorePrefixIterator(store, []byte("shared/"))
+			for ; iter.Valid(); iter.Next() {
+				count++
+			}
+			iter.Close()
+		}
+
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		return &sdk.Result{
+			Events:       counterEvent(sdk.EventTypeMessage, int64(count)).ToABCIEvents(),
+			MsgResponses: []*codectypes.Any{any},
+		}, nil
+	}))
+	txHandler := testTxHandler(
+		middleware.TxHandlerOptions{
+			LegacyRouter:     legacyRouter,
+			MsgServiceRouter: middleware.NewMsgServiceRouter(encCfg.InterfaceRegistry),
+			TxDecoder:        testTxDecoder(encCfg.Amino),
+		},
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil },
+	)
+	bapp.SetTxHandler(txHandler)
+}
+
+func TestDeliverTxs(t *testing.T) {
+	sequential := setupBaseApp(t, appendKeyValueTxHandlerOpt)
+	parallel := setupBaseApp(t, appendKeyValueTxHandlerOpt, baseapp.SetParallelTxExecution(4))
+	sequential.InitChain(abci.RequestInitChain{})
+	parallel.InitChain(abci.RequestInitChain{})
+
+	nBlocks := 3
+	txPerHeight := 40
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		var reqs []abci.RequestDeliverTx
+		for i := 0; i < txPerHeight; i++ {
+			// most txs write to keys of their own, some conflict on shared keys
+			key := fmt.Sprintf("tx/%d/%d", blockN, i)
+			switch {
+			case i%10 == 0:
+				key = fmt.Sprintf("shared/%d/%d", blockN, i)
+			case i%4 == 0:
+				key = fmt.Sprintf("shared/%d", i%3)
+			}
+			value := []byte(fmt.Sprintf("%d", i))
+			if i%7 == 0 {
+				value = []byte("fail")
+			}
+			txBytes, err := encCfg.Amino.Marshal(txTest{Msgs: []sdk.Msg{msgKeyValue{Key: []byte(key), Value: value}}, GasLimit: 1000000})
+			require.NoError(t, err)
+			reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+		}
+
+		header := tmproto.Header{Height: int64(blockN) + 1}
+		sequential.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallel.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		expected := sequential.DeliverTxs(reqs)
+		require.Equal(t, expected, parallel.DeliverTxs(reqs))
+		for i, res := range expected {
+			require.Equal(t, i%7 != 0, res.IsOK(), fmt.Sprintf("%v", res))
+		}
+
+		sequential.EndBlock(abci.RequestEndBlock{})
+		parallel.EndBlock(abci.RequestEndBlock{})
+		require.Equal(t, sequential.Commit().Data, parallel.Commit().Data)
+	}
+}
+
+// BenchmarkDeliverTxs compares sequential and parallel execution of blocks of txs which write keys
+// of their own, with and without also writing a key shared by all the txs of the block, like the
+// fee collector's balance is when every tx pays fees.
+func BenchmarkDeliverTxs(b *testing.B) {
+	txPerHeight := 100
+
+	for _, sharedKey := range []bool{false, true} {
+		for _, workers := range []int{0, 4} {
+			b.Run(fmt.Sprintf("shared key %t, %d workers", sharedKey, workers), func(b *testing.B) {
+				app := newBaseApp(b.Name(), appendKeyValueTxHandlerOpt, baseapp.SetParallelTxExecution(workers))
+				app.MountStores(capKey1, capKey2)
+				app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+				require.NoError(b, app.LoadLatestVersion())
+				app.InitChain(abci.RequestInitChain{})
+
+				blocks := make([][]abci.RequestDeliverTx, b.N)
+				for blockN := range blocks {
+					for i := 0; i < txPerHeight; i++ {
+						msgs := []sdk.Msg{msgKeyValue{Key: []byte(fmt.Sprintf("tx/%d/%d", blockN, i)), Value: []byte("1")}}
+						if sharedKey {
+							msgs = append(msgs, msgKeyValue{Key: []byte("fees"), Value: []byte("1")})
+						}
+						txBytes, err := encCfg.Amino.Marshal(txTest{Msgs: msgs, GasLimit: 1000000})
+						require.NoError(b, err)
+						blocks[blockN] = append(blocks[blockN], abci.RequestDeliverTx{Tx: txBytes})
+					}
+				}
+
+				b.ResetTimer()
+				for blockN, reqs := range blocks {
+					app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: int64(blockN) + 1}})
+					app.DeliverTxs(reqs)
+					app.EndBlock(abci.RequestEndBlock{})
+					app.Commit()
+				}
+			})
+		}
+	}
+}
+
+func TestAccessTracing(t *testing.T) {
+	app := setupBaseApp(t)
+	_, err := app.BlockAccesses(1)
+	require.Error(t, err, "access tracing is disabled")
+
+	for _, workers := range []int{0, 4} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			app := setupBaseApp(t, appendKeyValueTxHandlerOpt, baseapp.SetAccessTracing(2), baseapp.SetParallelTxExecution(workers))
+			app.InitChain(abci.RequestInitChain{})
+
+			accesses := func(key []byte) []*storetypes.StoreAccesses {
+				return []*storetypes.StoreAccesses{{StoreKey: capKey2.Name(), Reads: [][]byte{key}, Writes: [][]byte{key}}}
+			}
+
+			nBlocks := 3
+			var txBytes [][]byte
+			for blockN := 0; blockN < nBlocks; blockN++ {
+				height := int64(blockN) + 1
+				_, err := app.BlockAccesses(height)
+				require.Error(t, err)
+
+				// the first two txs conflict
+				keys := [][]byte{[]byte("key"), []byte("key"), []byte(fmt.Sprintf("key%d", height))}
+				txBytes = nil
+				var reqs []abci.RequestDeliverTx
+				for _, key := range keys {
+					bz, err := encCfg.Amino.Marshal(txTest{Msgs: []sdk.Msg{msgKeyValue{Key: key, Value: []byte("value")}}, GasLimit: 1000000})
+					require.NoError(t, err)
+					txBytes = append(txBytes, bz)
+					reqs = append(reqs, abci.RequestDeliverTx{Tx: bz})
+				}
+
+				app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+				for _, res := range app.DeliverTxs(reqs) {
+					require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+				}
+				app.EndBlock(abci.RequestEndBlock{})
+				app.Commit()
+
+				txs, err := app.BlockAccesses(height)
+				require.NoError(t, err)
+				require.Len(t, txs, len(keys))
+				for i, key := range keys {
+					require.Equal(t, []byte(tmtypes.Tx(txBytes[i]).Hash()), txs[i].TxHash)
+					require.Equal(t, accesses(key), txs[i].Stores)
+				}
+			}
+
+			// only the most recent blocks are kept
+			_, err := app.BlockAccesses(1)
+			require.Error(t, err)
+			_, err = app.BlockAccesses(2)
+			require.NoError(t, err)
+
+			// simulations record their accesses without writing them
+			_, result, simAccesses, err := app.SimulateWithAccesses(txBytes[2])
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			require.Equal(t, accesses([]byte("key3")), simAccesses)
+			require.Equal(t, []byte("value"), app.CheckState().Context().KVStore(capKey2).Get([]byte("key3")))
+		})
+	}
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1784,6 +1985,38 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// Test that queries are served from the query multistore if one is set.
+func TestQueryMultiStore(t *testing.T) {
+	key := []byte("hello")
+	writer := setupBaseApp(t)
+	commit := func(value []byte) {
+		writer.CMS().GetKVStore(capKey1).Set(key, value)
+		writer.CMS().Commit()
+	}
+	commit([]byte("v1"))
+	commit([]byte("v2"))
+
+	app := setupBaseApp(t, baseapp.SetQueryMultiStore(writer.CMS()))
+	require.Equal(t, int64(0), app.LastBlockHeight())
+
+	query := abci.RequestQuery{Path: "/store/key1/key", Data: key}
+	res := app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(2), res.Height)
+	require.Equal(t, []byte("v2"), res.Value)
+
+	ctx, err := app.CreateQueryContext(1, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), ctx.KVStore(capKey1).Get(key))
+
+	// Queries follow commits to the query multistore
+	commit([]byte("v3"))
+	ctx, err = app.CreateQueryContext(0, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), ctx.BlockHeight())
+	require.Equal(t, []byte("v3"), ctx.KVStore(capKey1).Get(key))
+}
+
 func TestGRPCQuery(t *testing.T) {
 	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
 		testdata.RegisterQueryServer(
@@ -1868,6 +2101,42 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	require.Panics(t, func() { app.GetMaximumBlockGas(ctx) })
 }
 
+func TestGetGasSchedule(t *testing.T) {
+	app := newBaseApp(t.Name())
+	app.MountStores(capKey1, capKey2)
+	ps := &paramStore{db: dbm.NewMemDB()}
+	app.SetParamStore(ps)
+
+	cheap := storetypes.GasConfig{HasCost: 1}
+	app.RegisterStoreGasConfig(capKey1, cheap)
+	app.RegisterStoreGasConfig(capKey2, cheap)
+
+	var beginBlockSchedule storetypes.GasSchedule
+	app.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		beginBlockSchedule = ctx.GasSchedule()
+		return abci.ResponseBeginBlock{}
+	})
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	schedule := app.GetGasSchedule(ctx)
+	require.Equal(t, cheap, schedule.GasConfig(capKey1, storetypes.KVGasConfig()))
+	require.Equal(t, cheap, schedule.GasConfig(capKey2, storetypes.KVGasConfig()))
+
+	// the gas schedule param overrides the registered gas configs
+	override := storetypes.GasConfig{HasCost: 2}
+	ps.Set(ctx, baseapp.ParamStoreKeyGasSchedule, storetypes.GasSchedule{
+		Stores: []storetypes.StoreGasConfig{{StoreKey: capKey2.Name(), GasConfig: override}},
+	})
+	schedule = app.GetGasSchedule(ctx)
+	require.Equal(t, cheap, schedule.GasConfig(capKey1, storetypes.KVGasConfig()))
+	require.Equal(t, override, schedule.GasConfig(capKey2, storetypes.KVGasConfig()))
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	require.Equal(t, schedule, beginBlockSchedule)
+}
+
 func TestListSnapshots(t *testing.T) {
 	app, teardown := setupBaseAppWithSnapshots(t, 5, 4)
 	defer teardown()
@@ -1880,8 +2149,8 @@ func TestListSnapshots(t *testing.T) {
 		s.Metadata = nil
 	}
 	assert.Equal(t, abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: 1, Chunks: 2},
-		{Height: 2, Format: 1, Chunks: 1},
+		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 3},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 2},
 	}}, resp)
 }
 
@@ -1895,13 +2164,13 @@ func TestLoadSnapshotChunk(t *testing.T) {
 		chunk       uint32
 		expectEmpty bool
 	}{
-		"Existing snapshot": {2, 1, 1, false},
-		"Missing height":    {100, 1, 1, true},
-		"Missing format":    {2, 2, 1, true},
-		"Missing chunk":     {2, 1, 9, true},
-		"Zero height":       {0, 1, 1, true},
+		"Existing snapshot": {2, 2, 1, false},
+		"Missing height":    {100, 2, 1, true},
+		"Missing format":    {2, 1, 1, true},
+		"Missing chunk":     {2, 2, 9, true},
+		"Zero height":       {0, 2, 1, true},
 		"Zero format":       {2, 0, 1, true},
-		"Zero chunk":        {2, 1, 0, false},
+		"Zero chunk":        {2, 2, 0, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
@@ -0,0 +1,44 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrepareProposal returns the txs of a block proposal, selected from the
+// app-side mempool in its order. Txs are selected until the next one would
+// exceed maxTxBytes, the total size of the txs, or maxGas, the total gas limit
+// of the txs, which is not limited if it is negative. It returns no txs if the
+// app has no mempool.
+//
+// NOTE: Tendermint v0.35 does not let apps prepare proposals, so PrepareProposal
+// is only called by apps, e.g. to build blocks in-process in tests.
+func (app *BaseApp) PrepareProposal(maxTxBytes, maxGas int64) [][]byte {
+	if app.mempool == nil {
+		return nil
+	}
+
+	var (
+		txs      [][]byte
+		txsBytes int64
+		txsGas   uint64
+	)
+	for it := app.mempool.Select(app.checkState.ctx); it != nil; it = it.Next() {
+		txBytes := it.TxBytes()
+		if txsBytes+int64(len(txBytes)) > maxTxBytes {
+			break
+		}
+
+		if maxGas >= 0 {
+			feeTx, ok := it.Tx().(sdk.FeeTx)
+			if !ok || txsGas+feeTx.GetGas() > uint64(maxGas) {
+				break
+			}
+			txsGas += feeTx.GetGas()
+		}
+
+		txs = append(txs, txBytes)
+		txsBytes += int64(len(txBytes))
+	}
+
+	return txs
+}
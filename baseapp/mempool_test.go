@@ -0,0 +1,53 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// mempoolTx is a signed tx with a gas limit, for the app-side mempool.
+type mempoolTx struct {
+	sender sdk.AccAddress
+	nonce  uint64
+	gas    uint64
+}
+
+func (tx mempoolTx) GetMsgs() []sdk.Msg                        { return nil }
+func (tx mempoolTx) ValidateBasic() error                      { return nil }
+func (tx mempoolTx) GetSigners() []sdk.AccAddress              { return []sdk.AccAddress{tx.sender} }
+func (tx mempoolTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+func (tx mempoolTx) GetGas() uint64                            { return tx.gas }
+func (tx mempoolTx) GetFee() sdk.Coins                         { return nil }
+func (tx mempoolTx) FeePayer() sdk.AccAddress                  { return tx.sender }
+func (tx mempoolTx) FeeGranter() sdk.AccAddress                { return nil }
+func (tx mempoolTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+
+func TestPrepareProposal(t *testing.T) {
+	mp := mempool.NewPriorityNonceMempool(mempool.DefaultPriorityNonceConfig())
+	app := setupBaseApp(t, func(app *baseapp.BaseApp) { app.SetMempool(mp) })
+	require.Empty(t, app.PrepareProposal(1000, -1))
+
+	_, _, sender1 := testdata.KeyTestPubAddr()
+	_, _, sender2 := testdata.KeyTestPubAddr()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger())
+	require.NoError(t, mp.Insert(ctx.WithTxBytes([]byte("tx1")).WithPriority(1), mempoolTx{sender1, 0, 100}))
+	require.NoError(t, mp.Insert(ctx.WithTxBytes([]byte("tx2")).WithPriority(3), mempoolTx{sender1, 1, 100}))
+	require.NoError(t, mp.Insert(ctx.WithTxBytes([]byte("tx3")).WithPriority(2), mempoolTx{sender2, 0, 100}))
+
+	require.Equal(t, [][]byte{[]byte("tx3"), []byte("tx1"), []byte("tx2")}, app.PrepareProposal(1000, -1))
+	// txs are selected until the next one exceeds the max bytes or the max gas
+	require.Equal(t, [][]byte{[]byte("tx3"), []byte("tx1")}, app.PrepareProposal(8, -1))
+	require.Equal(t, [][]byte{[]byte("tx3")}, app.PrepareProposal(1000, 199))
+}
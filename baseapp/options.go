@@ -9,7 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
@@ -84,6 +86,24 @@ func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
 }
 
+// SetQueryMultiStore sets a multistore to serve queries from instead of the
+// CommitMultiStore.
+func SetQueryMultiStore(qms sdk.QueryMultiStore) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetQueryMultiStore(qms) }
+}
+
+// SetParallelTxExecution returns a BaseApp option function that sets the number
+// of goroutines DeliverTxs executes a block's txs with.
+func SetParallelTxExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelTxExecution(workers) }
+}
+
+// SetAccessTracing returns a BaseApp option function that keeps the accesses of
+// the txs delivered in a number of recent blocks.
+func SetAccessTracing(blocks uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetAccessTracing(blocks) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -213,6 +233,52 @@ func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
 	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
 }
 
+// SetQueryMultiStore sets a multistore to serve queries from instead of the
+// CommitMultiStore, e.g. to serve queries from the state committed by another
+// process.
+func (app *BaseApp) SetQueryMultiStore(qms sdk.QueryMultiStore) {
+	if app.sealed {
+		panic("SetQueryMultiStore() on sealed BaseApp")
+	}
+	app.qms = qms
+}
+
+// SetParallelTxExecution sets the number of goroutines DeliverTxs executes a
+// block's txs with. Values less than 2 disable parallel execution. Nodes started
+// with the server's --parallel-tx-workers flag deliver each block's txs with
+// DeliverTxs, so apps should set the number of workers from the same flag.
+// Txs writing the same keys, such as the fee collector's balance, are executed
+// again after one another, see DeliverTxs.
+func (app *BaseApp) SetParallelTxExecution(workers int) {
+	if app.sealed {
+		panic("SetParallelTxExecution() on sealed BaseApp")
+	}
+	app.parallelTxWorkers = workers
+}
+
+// SetAccessTracing keeps the keys of each store accessed by the txs delivered in
+// a number of recent blocks, which are returned by BlockAccesses. Zero disables
+// access tracing.
+func (app *BaseApp) SetAccessTracing(blocks uint64) {
+	if app.sealed {
+		panic("SetAccessTracing() on sealed BaseApp")
+	}
+	app.accessTracingBlocks = blocks
+	app.blockAccesses = map[int64][]*storetypes.TxAccesses{}
+}
+
+// RegisterStoreGasConfig sets the gas config the store with a key is accessed
+// with, e.g. for a module to set the costs of its own store. A gas config for the
+// store in the GasSchedule param overrides it.
+func (app *BaseApp) RegisterStoreGasConfig(key storetypes.StoreKey, gasConfig storetypes.GasConfig) {
+	if app.sealed {
+		panic("RegisterStoreGasConfig() on sealed BaseApp")
+	}
+	app.gasSchedule = app.gasSchedule.Override(storetypes.GasSchedule{
+		Stores: []storetypes.StoreGasConfig{{StoreKey: key.Name(), GasConfig: gasConfig}},
+	})
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
 	if app.sealed {
@@ -241,7 +307,32 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 	for key, lis := range s.Listeners() {
 		app.cms.AddListeners(key, lis)
 	}
+	// collect the state changes committed in the KVStores exposed to the StreamingService
+	changeSet := new(changeSetListener)
+	for key := range s.Listeners() {
+		app.cms.AddListeners(key, []storetypes.WriteListener{changeSet})
+	}
 	// register the StreamingService within the BaseApp
-	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
-	app.abciListeners = append(app.abciListeners, s)
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, streamingListener{ABCIListener: s, changeSet: changeSet})
+}
+
+// SetStopNodeOnStreamingErr sets whether the node stops when a streaming service fails to process an ABCI message.
+// When false, the errors are only logged.
+func (app *BaseApp) SetStopNodeOnStreamingErr(stop bool) {
+	if app.sealed {
+		panic("SetStopNodeOnStreamingErr() on sealed BaseApp")
+	}
+
+	app.stopNodeOnStreamingErr = stop
+}
+
+// SetMempool sets the app-side mempool the txs of block proposals are selected
+// from by PrepareProposal. The same mempool must be given to the tx handler,
+// which keeps it in sync with the checked and delivered txs.
+func (app *BaseApp) SetMempool(mp mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+	app.mempool = mp
 }
@@ -0,0 +1,348 @@
+package baseapp
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// iteratorChunkSize is the number of items a lockedIterator reads from its parent at a time.
+const iteratorChunkSize = 64
+
+// DeliverTxs executes the txs of a block and returns their responses, in the same order and with
+// the same results as if each tx was passed to DeliverTx in turn.
+//
+// If parallel execution is enabled with SetParallelTxExecution, the txs are first executed
+// speculatively and concurrently against the state at the start of the block, recording the keys
+// each tx reads and writes. The results are then validated in order: a tx whose reads were not
+// written by an earlier tx of the block keeps its speculative result, and any other tx is executed
+// again against the current state. Tx handlers must therefore keep no state outside of the
+// multi-store which is shared across txs.
+//
+// Txs writing the same keys conflict, so only txs of a block which are independent of each other
+// gain from parallel execution. In particular, the fees of every tx are deducted into, and refunded
+// from, the balance of the fee collector module account, so a block of txs which all pay fees is
+// executed again almost entirely, and is delivered more slowly than sequentially. See
+// BenchmarkDeliverTxs.
+//
+// Parallel execution is disabled while tracing is enabled, as traces are written in execution order.
+//
+// Tendermint delivers txs one at a time, so the node buffers a block's txs passed to DeliverTx until
+// EndBlock to deliver them with DeliverTxs, see the server's --parallel-tx-workers flag.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	if app.parallelTxWorkers < 2 || len(reqs) < 2 || app.deliverState.ms.TracingEnabled() {
+		ress := make([]abci.ResponseDeliverTx, len(reqs))
+		for i, req := range reqs {
+			ress[i] = app.DeliverTx(req)
+		}
+		return ress
+	}
+
+	base := app.deliverState.ms
+	specs := app.speculateTxs(reqs, &lockedMultiStore{MultiStore: base, mtx: &sync.Mutex{}})
+
+	ress := make([]abci.ResponseDeliverTx, len(reqs))
+	written := map[storetypes.StoreKey]*accesskv.AccessSet{}
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	for i, req := range reqs {
+		spec := specs[i]
+		if spec.valid && !spec.ms.ReadsWrittenBy(written) && !spec.blockGasMeter.observed &&
+			!blockGasMeter.IsOutOfGas() && spec.blockGasMeter.GasConsumed() <= blockGasMeter.GasRemaining() {
+			blockGasMeter.ConsumeGas(spec.blockGasMeter.GasConsumed(), "block gas meter")
+		} else {
+			ms := accesskv.NewMultiStore(base)
+			ctx := app.deliverState.ctx.WithMultiStore(ms)
+			spec = &speculativeTx{ms: ms, res: app.deliverTx(app.newTxContext(ctx, runTxModeDeliver, req.Tx), req.Tx)}
+		}
+
+		spec.ms.Write()
+		if app.accessTracingBlocks > 0 {
+			app.recordAccesses(req.Tx, spec.ms)
+		}
+		for key, accesses := range spec.ms.Accesses() {
+			if _, has := written[key]; !has {
+				written[key] = accesskv.NewAccessSet()
+			}
+			written[key].MergeWrites(accesses)
+		}
+
+		ress[i] = spec.res
+		app.listenDeliverTx(req, spec.res)
+	}
+
+	return ress
+}
+
+// speculativeTx is the result of executing a tx against the state at the start of the block.
+type speculativeTx struct {
+	ms            *accesskv.MultiStore
+	blockGasMeter *speculativeGasMeter
+	res           abci.ResponseDeliverTx
+	// false if executing the tx panicked
+	valid bool
+}
+
+// speculateTxs executes txs concurrently on branches of a parent multi-store.
+func (app *BaseApp) speculateTxs(reqs []abci.RequestDeliverTx, parent sdk.MultiStore) []*speculativeTx {
+	specs := make([]*speculativeTx, len(reqs))
+	jobs := make(chan int, len(reqs))
+	for i := range reqs {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for w := 0; w < app.parallelTxWorkers && w < len(reqs); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				specs[i] = app.speculateTx(reqs[i], parent)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return specs
+}
+
+// speculateTx executes a tx on a branch of a parent multi-store, with a block gas meter of its own.
+func (app *BaseApp) speculateTx(req abci.RequestDeliverTx, parent sdk.MultiStore) (spec *speculativeTx) {
+	spec = &speculativeTx{
+		ms:            accesskv.NewMultiStore(parent),
+		blockGasMeter: newSpeculativeGasMeter(app.deliverState.ctx.BlockGasMeter()),
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Debug("speculative tx execution panicked", "err", r)
+			spec.valid = false
+		}
+	}()
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(spec.ms).
+		WithBlockGasMeter(spec.blockGasMeter)
+	spec.res = app.deliverTx(app.newTxContext(ctx, runTxModeDeliver, req.Tx), req.Tx)
+	spec.valid = true
+
+	return spec
+}
+
+// speculativeGasMeter stands in for the block gas meter during speculative execution. Gas is only
+// consumed from the block gas meter once the tx is validated, so a tx which observes the gas
+// consumed by the block must be executed again.
+type speculativeGasMeter struct {
+	sdk.GasMeter
+	observed bool
+}
+
+func newSpeculativeGasMeter(blockGasMeter sdk.GasMeter) *speculativeGasMeter {
+	if blockGasMeter.Limit() == 0 {
+		return &speculativeGasMeter{GasMeter: sdk.NewInfiniteGasMeter()}
+	}
+	return &speculativeGasMeter{GasMeter: sdk.NewGasMeter(blockGasMeter.Limit())}
+}
+
+func (g *speculativeGasMeter) GasConsumed() sdk.Gas {
+	g.observed = true
+	return g.GasMeter.GasConsumed()
+}
+
+func (g *speculativeGasMeter) GasConsumedToLimit() sdk.Gas {
+	g.observed = true
+	return g.GasMeter.GasConsumedToLimit()
+}
+
+func (g *speculativeGasMeter) GasRemaining() sdk.Gas {
+	g.observed = true
+	return g.GasMeter.GasRemaining()
+}
+
+func (g *speculativeGasMeter) RefundGas(amount sdk.Gas, descriptor string) {
+	g.observed = true
+	g.GasMeter.RefundGas(amount, descriptor)
+}
+
+func (g *speculativeGasMeter) IsPastLimit() bool {
+	g.observed = true
+	return g.GasMeter.IsPastLimit()
+}
+
+func (g *speculativeGasMeter) String() string {
+	g.observed = true
+	return g.GasMeter.String()
+}
+
+// lockedMultiStore serializes access to the KVStores of a multi-store shared by concurrently
+// executing txs.
+type lockedMultiStore struct {
+	sdk.MultiStore
+	mtx *sync.Mutex
+}
+
+// GetKVStore implements the MultiStore interface.
+func (ms *lockedMultiStore) GetKVStore(key storetypes.StoreKey) sdk.KVStore {
+	ms.mtx.Lock()
+	defer ms.mtx.Unlock()
+	return &lockedKVStore{parent: ms.MultiStore.GetKVStore(key), mtx: ms.mtx}
+}
+
+// lockedKVStore serializes access to a KVStore. It is only used as the parent of a branch, so
+// branching it is not supported.
+type lockedKVStore struct {
+	parent sdk.KVStore
+	mtx    *sync.Mutex
+}
+
+func (s *lockedKVStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+func (s *lockedKVStore) CacheWrap() storetypes.CacheWrap {
+	panic("cannot CacheWrap a lockedKVStore")
+}
+
+func (s *lockedKVStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	panic("cannot CacheWrapWithTrace a lockedKVStore")
+}
+
+func (s *lockedKVStore) CacheWrapWithListeners(_ storetypes.StoreKey, _ []storetypes.WriteListener) storetypes.CacheWrap {
+	panic("cannot CacheWrapWithListeners a lockedKVStore")
+}
+
+// Get returns a copy of the value, so that concurrent txs never share a value's buffer.
+func (s *lockedKVStore) Get(key []byte) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	value := s.parent.Get(key)
+	if value == nil {
+		return nil
+	}
+	return append([]byte{}, value...)
+}
+
+func (s *lockedKVStore) Has(key []byte) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.parent.Has(key)
+}
+
+func (s *lockedKVStore) Set(key, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.parent.Set(key, value)
+}
+
+func (s *lockedKVStore) Delete(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.parent.Delete(key)
+}
+
+func (s *lockedKVStore) Iterator(start, end []byte) sdk.Iterator {
+	return newLockedIterator(s, start, end, true)
+}
+
+func (s *lockedKVStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return newLockedIterator(s, start, end, false)
+}
+
+// lockedIterator iterates over a lockedKVStore in chunks, so that the parent's iterators are never
+// held open while the lock is released.
+type lockedIterator struct {
+	store      *lockedKVStore
+	start, end []byte
+	ascending  bool
+
+	keys, values [][]byte
+	// true once the parent has no items after the current chunk
+	exhausted bool
+}
+
+func newLockedIterator(store *lockedKVStore, start, end []byte, ascending bool) *lockedIterator {
+	iter := &lockedIterator{store: store, start: start, end: end, ascending: ascending}
+	iter.fill(start, end)
+	return iter
+}
+
+// fill reads the next chunk of items within a domain from the parent.
+func (iter *lockedIterator) fill(start, end []byte) {
+	iter.store.mtx.Lock()
+	defer iter.store.mtx.Unlock()
+
+	var parent sdk.Iterator
+	if iter.ascending {
+		parent = iter.store.parent.Iterator(start, end)
+	} else {
+		parent = iter.store.parent.ReverseIterator(start, end)
+	}
+	defer parent.Close()
+
+	iter.keys, iter.values = nil, nil
+	for ; parent.Valid(); parent.Next() {
+		if len(iter.keys) == iteratorChunkSize {
+			return
+		}
+		iter.keys = append(iter.keys, append([]byte{}, parent.Key()...))
+		iter.values = append(iter.values, append([]byte{}, parent.Value()...))
+	}
+	iter.exhausted = true
+}
+
+func (iter *lockedIterator) Domain() ([]byte, []byte) {
+	return iter.start, iter.end
+}
+
+func (iter *lockedIterator) Valid() bool {
+	return len(iter.keys) > 0
+}
+
+func (iter *lockedIterator) assertValid() {
+	if !iter.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+func (iter *lockedIterator) Next() {
+	iter.assertValid()
+	last := iter.keys[0]
+	iter.keys, iter.values = iter.keys[1:], iter.values[1:]
+	if len(iter.keys) > 0 || iter.exhausted {
+		return
+	}
+	if iter.ascending {
+		// the next key after last
+		iter.fill(append(last, 0), iter.end)
+	} else {
+		iter.fill(iter.start, last)
+	}
+}
+
+func (iter *lockedIterator) Key() []byte {
+	iter.assertValid()
+	return iter.keys[0]
+}
+
+func (iter *lockedIterator) Value() []byte {
+	iter.assertValid()
+	return iter.values[0]
+}
+
+func (iter *lockedIterator) Error() error {
+	return nil
+}
+
+func (iter *lockedIterator) Close() error {
+	iter.keys, iter.values = nil, nil
+	return nil
+}
+
+func (iter *lockedIterator) String() string {
+	return fmt.Sprintf("lockedIterator{%X, %X}", iter.start, iter.end)
+}
@@ -0,0 +1,42 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLockedIterator(t *testing.T) {
+	parent := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	for i := 0; i < iteratorChunkSize*3+5; i++ {
+		parent.Set([]byte(fmt.Sprintf("key%04d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	store := &lockedKVStore{parent: parent, mtx: &sync.Mutex{}}
+
+	domains := [][2][]byte{
+		{nil, nil},
+		{[]byte("key0010"), []byte("key0150")},
+		{[]byte("key0064"), nil},
+		{nil, []byte("key0128")},
+		{[]byte("key0500"), nil},
+	}
+	for _, domain := range domains {
+		require.Equal(t, items(parent.Iterator(domain[0], domain[1])), items(store.Iterator(domain[0], domain[1])))
+		require.Equal(t, items(parent.ReverseIterator(domain[0], domain[1])), items(store.ReverseIterator(domain[0], domain[1])))
+	}
+}
+
+func items(iter sdk.Iterator) (ret []string) {
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		ret = append(ret, fmt.Sprintf("%s=%s", iter.Key(), iter.Value()))
+	}
+	return
+}
@@ -6,6 +6,7 @@ import (
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,6 +20,10 @@ var (
 	ParamStoreKeyValidatorParams = []byte("ValidatorParams")
 )
 
+// ParamStoreKeyGasSchedule is the parameter store key for the gas schedule of the
+// KVStores.
+var ParamStoreKeyGasSchedule = []byte("GasSchedule")
+
 // ParamStore defines the interface the parameter store used by the BaseApp must
 // fulfill.
 type ParamStore interface {
@@ -83,3 +88,14 @@ func ValidateValidatorParams(i interface{}) error {
 
 	return nil
 }
+
+// ValidateGasSchedule defines a stateless validation on the GasSchedule. This
+// function is called whenever the parameters are updated or stored.
+func ValidateGasSchedule(i interface{}) error {
+	v, ok := i.(storetypes.GasSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
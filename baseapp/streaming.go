@@ -1,7 +1,9 @@
 package baseapp
 
 import (
+	"bytes"
 	"io"
+	"sort"
 	"sync"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,6 +20,10 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the steaming service with the latest Commit response and the state changes committed in
+	// the block, ordered by store key and key. The commit ID is made of the block height, ctx.BlockHeight(), and of
+	// the app hash, res.Data
+	ListenCommit(ctx types.Context, res abci.ResponseCommit, changeSet []*store.StoreKVPair) error
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
@@ -31,3 +37,45 @@ type StreamingService interface {
 	// Closer interface
 	io.Closer
 }
+
+// streamingListener is an ABCIListener registered with the BaseApp along with the collector of the state changes
+// committed in the KVStores it listens to
+type streamingListener struct {
+	ABCIListener
+	changeSet *changeSetListener
+}
+
+// changeSetListener is a WriteListener collecting the state changes written to the root multistore while a block
+// is committed. The state changes written to the branches of the multistore are ignored, as they may be reverted.
+type changeSetListener struct {
+	committing bool
+	changeSet  []*store.StoreKVPair
+}
+
+// OnWrite satisfies the store.WriteListener interface
+func (l *changeSetListener) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	if !l.committing {
+		return nil
+	}
+	l.changeSet = append(l.changeSet, &store.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// pop returns the collected state changes sorted by store key and key, keeping the order of the writes to the same
+// key, and resets the collector
+func (l *changeSetListener) pop() []*store.StoreKVPair {
+	changeSet := l.changeSet
+	l.changeSet = nil
+	sort.SliceStable(changeSet, func(i, j int) bool {
+		if changeSet[i].StoreKey != changeSet[j].StoreKey {
+			return changeSet[i].StoreKey < changeSet[j].StoreKey
+		}
+		return bytes.Compare(changeSet[i].Key, changeSet[j].Key) < 0
+	})
+	return changeSet
+}
@@ -0,0 +1,102 @@
+package baseapp_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &mockStreamingService{}
+
+// mockStreamingService records the change sets received on Commit.
+type mockStreamingService struct {
+	keys       []storetypes.StoreKey
+	changeSets [][]*storetypes.StoreKVPair
+	commitErr  error
+}
+
+func (m *mockStreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener, len(m.keys))
+	for _, key := range m.keys {
+		listeners[key] = nil
+	}
+	return listeners
+}
+
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+func (m *mockStreamingService) ListenCommit(_ sdk.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	m.changeSets = append(m.changeSets, changeSet)
+	return m.commitErr
+}
+
+func (m *mockStreamingService) Close() error { return nil }
+
+func TestStreamingListenCommit(t *testing.T) {
+	streamingService := &mockStreamingService{keys: []storetypes.StoreKey{capKey1}}
+	app := setupBaseApp(t, func(app *baseapp.BaseApp) {
+		app.SetStreamingService(streamingService)
+		app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set([]byte("b"), []byte("1"))
+			ctx.KVStore(capKey1).Set([]byte("a"), []byte("1"))
+			ctx.KVStore(capKey2).Set([]byte("a"), []byte("1"))
+			return abci.ResponseBeginBlock{}
+		})
+		app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Set([]byte("b"), []byte("2"))
+			ctx.KVStore(capKey1).Delete([]byte("c"))
+			return abci.ResponseEndBlock{}
+		})
+	})
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// only the final state of the exposed store is part of the change set
+	require.Equal(t, [][]*storetypes.StoreKVPair{{
+		{StoreKey: capKey1.Name(), Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: capKey1.Name(), Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: capKey1.Name(), Key: []byte("c"), Delete: true},
+	}}, streamingService.changeSets)
+}
+
+func TestStreamingStopNodeOnErr(t *testing.T) {
+	for _, stopNodeOnErr := range []bool{false, true} {
+		streamingService := &mockStreamingService{commitErr: errors.New("listener failure")}
+		app := setupBaseApp(t, func(app *baseapp.BaseApp) {
+			app.SetStreamingService(streamingService)
+			app.SetStopNodeOnStreamingErr(stopNodeOnErr)
+		})
+
+		header := tmproto.Header{Height: 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		if stopNodeOnErr {
+			require.Panics(t, func() { app.Commit() })
+		} else {
+			require.NotPanics(t, func() { app.Commit() })
+		}
+	}
+}
@@ -0,0 +1,586 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/debug/v1beta1/query.proto
+
+package debug
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/store/types"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetBlockAccessesRequest is the request type for the Service.GetBlockAccesses RPC method.
+type GetBlockAccessesRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockAccessesRequest) Reset()         { *m = GetBlockAccessesRequest{} }
+func (m *GetBlockAccessesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockAccessesRequest) ProtoMessage()    {}
+func (*GetBlockAccessesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f807b27efa413d25, []int{0}
+}
+func (m *GetBlockAccessesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockAccessesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockAccessesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockAccessesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockAccessesRequest.Merge(m, src)
+}
+func (m *GetBlockAccessesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockAccessesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockAccessesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockAccessesRequest proto.InternalMessageInfo
+
+func (m *GetBlockAccessesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetBlockAccessesResponse is the response type for the Service.GetBlockAccesses RPC method.
+type GetBlockAccessesResponse struct {
+	// txs are the accesses of each transaction of the block, in the order they were delivered.
+	Txs []*types.TxAccesses `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *GetBlockAccessesResponse) Reset()         { *m = GetBlockAccessesResponse{} }
+func (m *GetBlockAccessesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockAccessesResponse) ProtoMessage()    {}
+func (*GetBlockAccessesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f807b27efa413d25, []int{1}
+}
+func (m *GetBlockAccessesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockAccessesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockAccessesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockAccessesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockAccessesResponse.Merge(m, src)
+}
+func (m *GetBlockAccessesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockAccessesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockAccessesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockAccessesResponse proto.InternalMessageInfo
+
+func (m *GetBlockAccessesResponse) GetTxs() []*types.TxAccesses {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetBlockAccessesRequest)(nil), "cosmos.base.debug.v1beta1.GetBlockAccessesRequest")
+	proto.RegisterType((*GetBlockAccessesResponse)(nil), "cosmos.base.debug.v1beta1.GetBlockAccessesResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/debug/v1beta1/query.proto", fileDescriptor_f807b27efa413d25)
+}
+
+var fileDescriptor_f807b27efa413d25 = []byte{
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4a, 0x33, 0x41,
+	0x14, 0x85, 0x33, 0x7f, 0x20, 0x3f, 0x8c, 0x8d, 0x6c, 0xa1, 0x31, 0xc8, 0x12, 0x02, 0x81, 0x34,
+	0xce, 0x90, 0x8d, 0x20, 0xd8, 0x99, 0x46, 0xeb, 0xc4, 0xca, 0x46, 0x76, 0x27, 0x97, 0xc9, 0x90,
+	0x64, 0xef, 0x66, 0xef, 0x6c, 0x88, 0x88, 0x8d, 0x4f, 0x20, 0xf8, 0x30, 0x3e, 0x80, 0x8d, 0x65,
+	0xc0, 0xc6, 0x52, 0x12, 0x1f, 0x44, 0x92, 0xd9, 0x84, 0xa0, 0xa4, 0xb0, 0x5a, 0x96, 0x39, 0xdf,
+	0xb9, 0xe7, 0x9e, 0xcb, 0xeb, 0x0a, 0x69, 0x84, 0x24, 0xa3, 0x90, 0x40, 0xf6, 0x20, 0xca, 0xb4,
+	0x9c, 0x34, 0x23, 0xb0, 0x61, 0x53, 0x8e, 0x33, 0x48, 0xef, 0x44, 0x92, 0xa2, 0x45, 0xef, 0xc8,
+	0xc9, 0xc4, 0x52, 0x26, 0x56, 0x32, 0x91, 0xcb, 0x2a, 0xc7, 0x1a, 0x51, 0x0f, 0x41, 0x86, 0x89,
+	0x91, 0x61, 0x1c, 0xa3, 0x0d, 0xad, 0xc1, 0x98, 0x1c, 0x58, 0x69, 0x6c, 0xfb, 0x93, 0xc5, 0x14,
+	0x36, 0xfe, 0xa1, 0x52, 0x40, 0x04, 0xb9, 0xb2, 0xd6, 0xe4, 0x87, 0x97, 0x60, 0xdb, 0x43, 0x54,
+	0x83, 0x8b, 0xfc, 0xa5, 0x03, 0xe3, 0x0c, 0xc8, 0x7a, 0x07, 0xbc, 0xd4, 0x07, 0xa3, 0xfb, 0xb6,
+	0xcc, 0xaa, 0xac, 0x51, 0xec, 0xe4, 0x7f, 0xb5, 0x2e, 0x2f, 0xff, 0x46, 0x28, 0xc1, 0x98, 0xc0,
+	0x3b, 0xe3, 0x45, 0x3b, 0xa5, 0x32, 0xab, 0x16, 0x1b, 0x7b, 0x41, 0x5d, 0x6c, 0xe7, 0x5f, 0xc5,
+	0x58, 0xe7, 0x17, 0xd7, 0xd3, 0x0d, 0xbb, 0x24, 0x82, 0x57, 0xc6, 0xff, 0x77, 0x21, 0x9d, 0x18,
+	0x05, 0xde, 0x0b, 0xe3, 0xfb, 0x3f, 0x27, 0x78, 0x81, 0xd8, 0x59, 0x86, 0xd8, 0xb1, 0x41, 0xa5,
+	0xf5, 0x27, 0xc6, 0xad, 0x50, 0x3b, 0x7f, 0x7c, 0xff, 0x7a, 0xfe, 0x77, 0xea, 0x05, 0x72, 0xf7,
+	0x91, 0xa2, 0x25, 0x79, 0xbb, 0xae, 0x52, 0xde, 0xbb, 0x66, 0x1e, 0xda, 0x57, 0x6f, 0x73, 0x9f,
+	0xcd, 0xe6, 0x3e, 0xfb, 0x9c, 0xfb, 0xec, 0x69, 0xe1, 0x17, 0x66, 0x0b, 0xbf, 0xf0, 0xb1, 0xf0,
+	0x0b, 0x37, 0x42, 0x1b, 0xdb, 0xcf, 0x22, 0xa1, 0x70, 0xb4, 0xf6, 0x75, 0x9f, 0x13, 0xea, 0x0d,
+	0xa4, 0x1a, 0x1a, 0x88, 0xad, 0xd4, 0x69, 0xa2, 0xdc, 0xa4, 0xa8, 0xb4, 0x3a, 0x4f, 0xeb, 0x7b,
+	0x00, 0x70, 0x61, 0xc4, 0x8a, 0x2a, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// GetBlockAccesses queries the keys of each store accessed by the transactions of a recently
+	// delivered block. Accesses are only recorded by nodes which enable access tracing.
+	GetBlockAccesses(ctx context.Context, in *GetBlockAccessesRequest, opts ...grpc.CallOption) (*GetBlockAccessesResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) GetBlockAccesses(ctx context.Context, in *GetBlockAccessesRequest, opts ...grpc.CallOption) (*GetBlockAccessesResponse, error) {
+	out := new(GetBlockAccessesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.debug.v1beta1.Service/GetBlockAccesses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceServer is the server API for Service service.
+type ServiceServer interface {
+	// GetBlockAccesses queries the keys of each store accessed by the transactions of a recently
+	// delivered block. Accesses are only recorded by nodes which enable access tracing.
+	GetBlockAccesses(context.Context, *GetBlockAccessesRequest) (*GetBlockAccessesResponse, error)
+}
+
+// UnimplementedServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (*UnimplementedServiceServer) GetBlockAccesses(ctx context.Context, req *GetBlockAccessesRequest) (*GetBlockAccessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockAccesses not implemented")
+}
+
+func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
+	s.RegisterService(&_Service_serviceDesc, srv)
+}
+
+func _Service_GetBlockAccesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockAccessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetBlockAccesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.debug.v1beta1.Service/GetBlockAccesses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetBlockAccesses(ctx, req.(*GetBlockAccessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Service_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.debug.v1beta1.Service",
+	HandlerType: (*ServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlockAccesses",
+			Handler:    _Service_GetBlockAccesses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/debug/v1beta1/query.proto",
+}
+
+func (m *GetBlockAccessesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockAccessesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockAccessesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockAccessesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockAccessesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockAccessesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetBlockAccessesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockAccessesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetBlockAccessesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockAccessesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockAccessesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockAccessesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockAccessesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockAccessesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &types.TxAccesses{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
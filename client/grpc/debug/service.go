@@ -0,0 +1,58 @@
+package debug
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// AccessTracer records the keys accessed by the txs of recently delivered blocks. It is
+// implemented by BaseApp.
+type AccessTracer interface {
+	BlockAccesses(height int64) ([]*storetypes.TxAccesses, error)
+}
+
+// This is the struct that we will implement all the handlers on.
+type queryServer struct {
+	tracer AccessTracer
+}
+
+var _ ServiceServer = queryServer{}
+
+// NewQueryServer creates a new debug query server.
+func NewQueryServer(tracer AccessTracer) ServiceServer {
+	return queryServer{tracer: tracer}
+}
+
+// GetBlockAccesses implements ServiceServer.GetBlockAccesses
+func (s queryServer) GetBlockAccesses(_ context.Context, req *GetBlockAccessesRequest) (*GetBlockAccessesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	txs, err := s.tracer.BlockAccesses(req.Height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &GetBlockAccessesResponse{Txs: txs}, nil
+}
+
+// RegisterDebugService registers the debug queries on the gRPC router.
+func RegisterDebugService(qrt gogogrpc.Server, tracer AccessTracer) {
+	RegisterServiceServer(qrt, NewQueryServer(tracer))
+}
+
+// RegisterGRPCGatewayRoutes mounts the debug service's GRPC-gateway routes on the
+// given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
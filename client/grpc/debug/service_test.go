@@ -0,0 +1,51 @@
+package debug_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/debug"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+type mockTracer map[int64][]*storetypes.TxAccesses
+
+func (t mockTracer) BlockAccesses(height int64) ([]*storetypes.TxAccesses, error) {
+	txs, ok := t[height]
+	if !ok {
+		return nil, fmt.Errorf("no accesses recorded at height %d", height)
+	}
+	return txs, nil
+}
+
+func TestGetBlockAccesses(t *testing.T) {
+	txs := []*storetypes.TxAccesses{
+		{TxHash: []byte("hash"), Stores: []*storetypes.StoreAccesses{{StoreKey: "bank", Reads: [][]byte{[]byte("key")}}}},
+	}
+	srv := debug.NewQueryServer(mockTracer{2: txs})
+
+	res, err := srv.GetBlockAccesses(context.Background(), &debug.GetBlockAccessesRequest{Height: 2})
+	require.NoError(t, err)
+	require.Equal(t, txs, res.Txs)
+
+	testCases := []struct {
+		name    string
+		req     *debug.GetBlockAccessesRequest
+		expCode codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"invalid height", &debug.GetBlockAccessesRequest{Height: 0}, codes.InvalidArgument},
+		{"not recorded", &debug.GetBlockAccessesRequest{Height: 1}, codes.NotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.GetBlockAccesses(context.Background(), tc.req)
+			require.Equal(t, tc.expCode, status.Code(err))
+		})
+	}
+}
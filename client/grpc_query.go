@@ -32,7 +32,8 @@ var fallBackCodec = codec.NewProtoCodec(failingInterfaceRegistry{})
 func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) (err error) {
 	// Two things can happen here:
 	// 1. either we're broadcasting a Tx, in which call we call Tendermint's broadcast endpoint directly,
-	// 2. or we are querying for state, in which case we call ABCI's Query.
+	// 2. or we are querying for state, in which case we call the gRPC server if
+	// a gRPC client connection is set, or ABCI's Query otherwise.
 
 	// In both cases, we don't allow empty request args (it will panic unexpectedly).
 	if reflect.ValueOf(req).IsNil() {
@@ -55,6 +56,19 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 		return err
 	}
 
+	// Case 2a. Querying state via gRPC, passing the context's height unless the
+	// request's metadata already sets it.
+	if ctx.GRPCClient != nil {
+		md, _ := metadata.FromOutgoingContext(grpcCtx)
+		if ctx.Height > 0 && len(md.Get(grpctypes.GRPCBlockHeightHeader)) == 0 {
+			grpcCtx = metadata.AppendToOutgoingContext(
+				grpcCtx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(ctx.Height, 10))
+		}
+		opts = append(opts, grpc.ForceCodec(ctx.gRPCCodec()))
+		return ctx.GRPCClient.Invoke(grpcCtx, method, req, reply, opts...)
+	}
+
+	// Case 2b. Querying state via ABCI.
 	reqBz, err := ctx.gRPCCodec().Marshal(req)
 	if err != nil {
 		return err
@@ -78,6 +78,29 @@ func (s *IntegrationTestSuite) TestGRPCQuery() {
 	s.Require().Equal([]string{"1"}, blockHeight)
 }
 
+func (s *IntegrationTestSuite) TestGRPCQuery_GRPCClient() {
+	val0 := s.network.Validators[0]
+	grpcClient, err := grpc.Dial(val0.AppConfig.GRPC.Address, grpc.WithInsecure())
+	s.Require().NoError(err)
+	defer grpcClient.Close()
+
+	// gRPC queries should be made via the gRPC client, passing the context's height
+	clientCtx := val0.ClientCtx.WithClient(nil).WithGRPCClient(grpcClient).WithHeight(1)
+	denom := fmt.Sprintf("%stoken", val0.Moniker)
+	var header metadata.MD
+	bankRes, err := banktypes.NewQueryClient(clientCtx).Balance(
+		context.Background(),
+		&banktypes.QueryBalanceRequest{Address: val0.Address.String(), Denom: denom},
+		grpc.Header(&header),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		sdk.NewCoin(denom, s.network.Config.AccountTokens),
+		*bankRes.GetBalance(),
+	)
+	s.Require().Equal([]string{"1"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -61,6 +62,11 @@ func CopyTx(tx signing.Tx, builder client.TxBuilder, ignoreSignatureError bool)
 	builder.SetFeeAmount(tx.GetFee())
 	builder.SetGasLimit(tx.GetGas())
 	builder.SetTimeoutHeight(tx.GetTimeoutHeight())
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		if err := builder.SetUnordered(true); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
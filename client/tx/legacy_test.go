@@ -110,6 +110,22 @@ func (s *TestSuite) TestCopyTx() {
 	s.Require().Equal(aminoBuilder.GetTx().GetMsgs()[1], aminoBuilder2.GetTx().GetMsgs()[1])
 }
 
+func (s *TestSuite) TestCopyUnorderedTx() {
+	protoBuilder := s.protoCfg.NewTxBuilder()
+	buildTestTx(s.T(), protoBuilder)
+
+	// amino txs cannot be unordered
+	aminoBuilder := s.aminoCfg.NewTxBuilder()
+	s.Require().NoError(aminoBuilder.SetUnordered(false))
+	s.Require().NoError(tx2.CopyTx(protoBuilder.GetTx(), aminoBuilder, false))
+	s.Require().NoError(protoBuilder.SetUnordered(true))
+	s.Require().Error(tx2.CopyTx(protoBuilder.GetTx(), s.aminoCfg.NewTxBuilder(), false))
+
+	protoBuilder2 := s.protoCfg.NewTxBuilder()
+	s.Require().NoError(tx2.CopyTx(protoBuilder.GetTx(), protoBuilder2, false))
+	s.Require().True(protoBuilder2.GetTx().(types.TxWithUnordered).GetUnordered())
+}
+
 func (s *TestSuite) TestConvertTxToStdTx() {
 	// proto tx
 	protoBuilder := s.protoCfg.NewTxBuilder()
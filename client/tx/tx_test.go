@@ -131,6 +131,23 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedUnorderedTx(t *testing.T) {
+	txf := tx.Factory{}.
+		WithTxConfig(NewTestTxConfig()).
+		WithAccountNumber(50).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithUnordered(true)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+	_, err := txf.BuildUnsignedTx(msg)
+	require.Error(t, err)
+
+	tx, err := txf.WithTimeoutHeight(100).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	require.True(t, tx.GetTx().(sdk.TxWithUnordered).GetUnordered())
+}
+
 func TestSign(t *testing.T) {
 	requireT := require.New(t)
 	path := hd.CreateHDPath(118, 0, 0).String()
@@ -0,0 +1,68 @@
+package pebbledb
+
+import (
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	dbutil "github.com/cosmos/cosmos-sdk/db/internal"
+)
+
+type pebbleBatch struct {
+	batch *pebble.Batch
+	mgr   *dbManager
+}
+
+var _ db.DBWriter = (*pebbleBatch)(nil)
+
+func (mgr *dbManager) newPebbleBatch() *pebbleBatch {
+	return &pebbleBatch{
+		batch: mgr.current.NewBatch(),
+		mgr:   mgr,
+	}
+}
+
+// Set implements DBWriter.
+func (b *pebbleBatch) Set(key, value []byte) error {
+	if err := dbutil.ValidateKv(key, value); err != nil {
+		return err
+	}
+	if b.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete implements DBWriter.
+func (b *pebbleBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return db.ErrKeyEmpty
+	}
+	if b.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	return b.batch.Delete(key, nil)
+}
+
+// Commit implements DBWriter.
+func (b *pebbleBatch) Commit() (err error) {
+	if b.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	defer func() { err = dbutil.CombineErrors(err, b.Discard(), "Discard also failed") }()
+	err = b.mgr.conflicts.commit(b.batch, 0, nil, nil)
+	return
+}
+
+// Discard implements DBWriter.
+func (b *pebbleBatch) Discard() error {
+	if b.batch == nil {
+		return nil
+	}
+	defer func() {
+		b.batch = nil
+		atomic.AddInt32(&b.mgr.openWriters, -1)
+	}()
+	return b.batch.Close()
+}
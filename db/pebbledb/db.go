@@ -0,0 +1,755 @@
+package pebbledb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/google/btree"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	dbutil "github.com/cosmos/cosmos-sdk/db/internal"
+)
+
+var (
+	currentDBFileName    string = "current.db"
+	checkpointFileFormat string = "%020d.db"
+)
+
+// The degree of the B-trees holding the writes of read-write transactions
+const bTreeDegree = 32
+
+// ErrConflict is returned when committing a read-write transaction which read or wrote a key, or
+// iterated over a range containing a key, that was written by another transaction committed after
+// it was opened.
+var ErrConflict = errors.New("transaction conflict")
+
+var (
+	_ db.DBConnection = (*PebbleDB)(nil)
+	_ db.DBReader     = (*dbTxn)(nil)
+	_ db.DBWriter     = (*dbWriter)(nil)
+	_ db.DBReadWriter = (*dbWriter)(nil)
+)
+
+// PebbleDB is a connection to a Pebble key-value database.
+type PebbleDB = dbManager
+
+type dbManager struct {
+	current *pebble.DB
+	dir     string
+	vmgr    *db.VersionManager
+	mtx     sync.RWMutex
+	// Track open DBWriters
+	openWriters int32
+	cpCache     checkpointCache
+	conflicts   conflictTracker
+}
+
+type checkpointCache struct {
+	cache map[uint64]*cpCacheEntry
+	mtx   sync.RWMutex
+}
+
+type cpCacheEntry struct {
+	cxn       *pebble.DB
+	openCount uint
+}
+
+// Pebble does not support transactions, so write conflicts are detected by tracking the keys
+// written by each commit while read-write transactions are open.
+type conflictTracker struct {
+	mtx sync.Mutex
+	// Number of commits made so far
+	seq uint64
+	// Number of open read-write transactions
+	openTxns int
+	// Sequence number of the last commit which wrote each key, while transactions are open
+	written map[string]uint64
+}
+
+// Read-only view of either the current DB or a checkpoint
+type pebbleReader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(*pebble.IterOptions) *pebble.Iterator
+}
+
+type dbTxn struct {
+	reader   pebbleReader
+	snapshot *pebble.Snapshot
+	// Cache of the checkpoint read, if not reading a snapshot
+	cpCache *checkpointCache
+	version uint64
+}
+
+// Read-write transaction, reading a snapshot of the DB taken when it was opened
+type dbWriter struct {
+	snapshot *pebble.Snapshot
+	// Keys written by the transaction, which are read in place of the snapshot's
+	writes *btree.BTree
+	mgr    *dbManager
+	// Commit sequence number at which the transaction was opened
+	startSeq uint64
+	// Keys read by the transaction
+	reads map[string]struct{}
+	// Ranges iterated by the transaction
+	readRanges []keyRange
+}
+
+// Key written by a read-write transaction, ordered by key
+type writeItem struct {
+	key, value []byte
+	deleted    bool
+}
+
+// Range of keys from start, inclusive, to end, exclusive. A nil bound is unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+// NewDB creates a new Pebble key-value database inside the given directory.
+// If dir does not exist, it will be created.
+func NewDB(dir string) (*dbManager, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	mgr := &dbManager{
+		dir:       dir,
+		cpCache:   checkpointCache{cache: map[uint64]*cpCacheEntry{}},
+		conflicts: conflictTracker{written: map[string]uint64{}},
+	}
+
+	err := os.MkdirAll(mgr.checkpointsDir(), 0755)
+	if err != nil {
+		return nil, err
+	}
+	if mgr.vmgr, err = readVersions(mgr.checkpointsDir()); err != nil {
+		return nil, err
+	}
+	dbPath := filepath.Join(dir, currentDBFileName)
+	// if the current db file is missing but there are checkpoints, restore it
+	if mgr.vmgr.Count() > 0 {
+		if _, err = os.Stat(dbPath); os.IsNotExist(err) {
+			err = mgr.restoreFromCheckpoint(mgr.vmgr.Last(), dbPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	mgr.current, err = pebble.Open(dbPath, newOptions(false))
+	if err != nil {
+		return nil, err
+	}
+	return mgr, nil
+}
+
+func newOptions(readOnly bool) *pebble.Options {
+	opts := &pebble.Options{ReadOnly: readOnly}
+	return opts.EnsureDefaults()
+}
+
+func (mgr *dbManager) checkpointsDir() string {
+	return filepath.Join(mgr.dir, "checkpoints")
+}
+
+// Reads directory for checkpoints files
+func readVersions(dir string) (*db.VersionManager, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []uint64
+	for _, f := range files {
+		var version uint64
+		if _, err := fmt.Sscanf(f.Name(), checkpointFileFormat, &version); err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return db.NewVersionManager(versions), nil
+}
+
+// Path of a checkpoint being saved or deleted, outside the checkpoints directory.
+func (mgr *dbManager) tmpCheckpointPath() string {
+	return filepath.Join(mgr.dir, "checkpoint.tmp")
+}
+
+func (mgr *dbManager) checkpointPath(version uint64) (string, error) {
+	return checkpointPath(mgr.checkpointsDir(), version)
+}
+
+func checkpointPath(checkpointsDir string, version uint64) (string, error) {
+	dbPath := filepath.Join(checkpointsDir, fmt.Sprintf(checkpointFileFormat, version))
+	if stat, err := os.Stat(dbPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = db.ErrVersionDoesNotExist
+		}
+		return "", err
+	} else if !stat.IsDir() {
+		return "", db.ErrVersionDoesNotExist
+	}
+	return dbPath, nil
+}
+
+func (mgr *dbManager) openCheckpoint(version uint64) (*pebble.DB, error) {
+	return mgr.cpCache.open(mgr.checkpointsDir(), version, newOptions(true))
+}
+
+// Reader implements DBConnection.
+func (mgr *dbManager) Reader() db.DBReader {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	snapshot := mgr.current.NewSnapshot()
+	return &dbTxn{
+		reader:   snapshot,
+		snapshot: snapshot,
+	}
+}
+
+// ReaderAt implements DBConnection.
+func (mgr *dbManager) ReaderAt(version uint64) (db.DBReader, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	d, err := mgr.openCheckpoint(version)
+	if err != nil {
+		return nil, err
+	}
+	return &dbTxn{
+		reader:  d,
+		cpCache: &mgr.cpCache,
+		version: version,
+	}, nil
+}
+
+// ReadWriter implements DBConnection.
+func (mgr *dbManager) ReadWriter() db.DBReadWriter {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	atomic.AddInt32(&mgr.openWriters, 1)
+	return &dbWriter{
+		snapshot: mgr.current.NewSnapshot(),
+		writes:   btree.New(bTreeDegree),
+		mgr:      mgr,
+		startSeq: mgr.conflicts.begin(),
+		reads:    map[string]struct{}{},
+	}
+}
+
+// Writer implements DBConnection.
+func (mgr *dbManager) Writer() db.DBWriter {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	atomic.AddInt32(&mgr.openWriters, 1)
+	return mgr.newPebbleBatch()
+}
+
+// Versions implements DBConnection.
+func (mgr *dbManager) Versions() (db.VersionSet, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	return mgr.vmgr, nil
+}
+
+// SaveNextVersion implements DBConnection.
+func (mgr *dbManager) SaveNextVersion() (uint64, error) {
+	return mgr.save(0)
+}
+
+// SaveVersion implements DBConnection.
+func (mgr *dbManager) SaveVersion(target uint64) error {
+	if target == 0 {
+		return db.ErrInvalidVersion
+	}
+	_, err := mgr.save(target)
+	return err
+}
+
+func (mgr *dbManager) save(target uint64) (uint64, error) {
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	if mgr.openWriters > 0 {
+		return 0, db.ErrOpenTransactions
+	}
+	newVmgr := mgr.vmgr.Copy()
+	target, err := newVmgr.Save(target)
+	if err != nil {
+		return 0, err
+	}
+	// The checkpoint is created in a temporary directory and then moved into place, so that a
+	// ReadOnlyDB listing the checkpoints in another process never sees a partial one.
+	tmpDir := mgr.tmpCheckpointPath()
+	if err = os.RemoveAll(tmpDir); err != nil {
+		return 0, err
+	}
+	if err = mgr.current.Checkpoint(tmpDir, pebble.WithFlushedWAL()); err != nil {
+		return 0, err
+	}
+	dir := filepath.Join(mgr.checkpointsDir(), fmt.Sprintf(checkpointFileFormat, target))
+	if err = os.Rename(tmpDir, dir); err != nil {
+		return 0, err
+	}
+	mgr.vmgr = newVmgr
+	return target, nil
+}
+
+// DeleteVersion implements DBConnection.
+func (mgr *dbManager) DeleteVersion(ver uint64) error {
+	if mgr.cpCache.has(ver) {
+		return db.ErrOpenTransactions
+	}
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	dbPath, err := mgr.checkpointPath(ver)
+	if err != nil {
+		return err
+	}
+	// The checkpoint is moved out of the checkpoints directory before it is removed, for the same
+	// reason it is moved into place when saved.
+	tmpDir := mgr.tmpCheckpointPath()
+	if err = os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+	if err = os.Rename(dbPath, tmpDir); err != nil {
+		return err
+	}
+	mgr.vmgr = mgr.vmgr.Copy()
+	mgr.vmgr.Delete(ver)
+	return os.RemoveAll(tmpDir)
+}
+
+// Revert implements DBConnection.
+func (mgr *dbManager) Revert() (err error) {
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	if mgr.openWriters > 0 {
+		return db.ErrOpenTransactions
+	}
+	// Close current connection and replace it with a checkpoint (created from the last checkpoint)
+	if err = mgr.current.Close(); err != nil {
+		return
+	}
+	dbPath := filepath.Join(mgr.dir, currentDBFileName)
+	err = os.RemoveAll(dbPath)
+	if err != nil {
+		return
+	}
+	if last := mgr.vmgr.Last(); last != 0 {
+		err = mgr.restoreFromCheckpoint(last, dbPath)
+		if err != nil {
+			return
+		}
+	}
+	mgr.current, err = pebble.Open(dbPath, newOptions(false))
+	return
+}
+
+// Pebble can't create a checkpoint from a read-only DB, so the checkpoint's files are copied to a
+// temporary directory which is then moved into place.
+func (mgr *dbManager) restoreFromCheckpoint(version uint64, path string) error {
+	cpPath, err := mgr.checkpointPath(version)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err = os.RemoveAll(tmpPath); err != nil {
+		return err
+	}
+	if err = copyDir(cpPath, tmpPath); err != nil {
+		return dbutil.CombineErrors(err, os.RemoveAll(tmpPath), "os.RemoveAll also failed")
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// Copies the regular files of a flat directory, which is all a Pebble checkpoint contains.
+func copyDir(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if err = copyFile(filepath.Join(src, entry.Name()), filepath.Join(dst, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return
+	}
+	defer func() { err = dbutil.CombineErrors(err, out.Close(), "out.Close also failed") }()
+	if _, err = io.Copy(out, in); err != nil {
+		return
+	}
+	return out.Sync()
+}
+
+// Close implements DBConnection. It also closes the checkpoints opened by transactions which have
+// not been discarded.
+func (mgr *dbManager) Close() error {
+	return dbutil.CombineErrors(mgr.cpCache.close(), mgr.current.Close(), "current Close also failed")
+}
+
+// Stats implements DBConnection.
+func (mgr *dbManager) Stats() map[string]string {
+	return map[string]string{"pebble.metrics": mgr.current.Metrics().String()}
+}
+
+// Get implements DBReader.
+func (tx *dbTxn) Get(key []byte) ([]byte, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return nil, db.ErrKeyEmpty
+	}
+	return get(tx.reader, key)
+}
+
+// Has implements DBReader.
+func (tx *dbTxn) Has(key []byte) (bool, error) {
+	bytes, err := tx.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return bytes != nil, nil
+}
+
+// Iterator implements DBReader.
+func (tx *dbTxn) Iterator(start, end []byte) (db.Iterator, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	return newPebbleIterator(tx.reader, start, end, false)
+}
+
+// ReverseIterator implements DBReader.
+func (tx *dbTxn) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	return newPebbleIterator(tx.reader, start, end, true)
+}
+
+// Discard implements DBReader.
+func (tx *dbTxn) Discard() error {
+	if tx.reader == nil {
+		return nil // Discard() is idempotent
+	}
+	tx.reader = nil
+	if tx.snapshot != nil {
+		return tx.snapshot.Close()
+	}
+	if !tx.cpCache.decrement(tx.version) {
+		return fmt.Errorf("transaction has no corresponding checkpoint cache entry: %v", tx.version)
+	}
+	return nil
+}
+
+// Get implements DBReader.
+func (tx *dbWriter) Get(key []byte) ([]byte, error) {
+	if tx.snapshot == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return nil, db.ErrKeyEmpty
+	}
+	tx.reads[string(key)] = struct{}{}
+	if i := tx.writes.Get(&writeItem{key: key}); i != nil {
+		write := i.(*writeItem)
+		if write.deleted {
+			return nil, nil
+		}
+		return append([]byte{}, write.value...), nil
+	}
+	return get(tx.snapshot, key)
+}
+
+// Has implements DBReader.
+func (tx *dbWriter) Has(key []byte) (bool, error) {
+	bytes, err := tx.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return bytes != nil, nil
+}
+
+// Iterator implements DBReader.
+func (tx *dbWriter) Iterator(start, end []byte) (db.Iterator, error) {
+	return tx.newIterator(start, end, false)
+}
+
+// ReverseIterator implements DBReader.
+func (tx *dbWriter) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	return tx.newIterator(start, end, true)
+}
+
+func (tx *dbWriter) newIterator(start, end []byte, isReverse bool) (db.Iterator, error) {
+	if tx.snapshot == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	source, err := newPebbleIterator(tx.snapshot, start, end, isReverse)
+	if err != nil {
+		return nil, err
+	}
+	tx.readRanges = append(tx.readRanges, keyRange{
+		start: append([]byte(nil), start...),
+		end:   append([]byte(nil), end...),
+	})
+	return newWriterIterator(source, tx.writesInRange(start, end, isReverse)), nil
+}
+
+// Returns the keys written in a range, in iteration order.
+func (tx *dbWriter) writesInRange(start, end []byte, isReverse bool) (writes []*writeItem) {
+	visit := func(i btree.Item) bool {
+		write := i.(*writeItem)
+		if end != nil && bytes.Compare(write.key, end) >= 0 {
+			return false
+		}
+		writes = append(writes, write)
+		return true
+	}
+	if start == nil {
+		tx.writes.Ascend(visit)
+	} else {
+		tx.writes.AscendGreaterOrEqual(&writeItem{key: start}, visit)
+	}
+	if isReverse {
+		for i, j := 0, len(writes)-1; i < j; i, j = i+1, j-1 {
+			writes[i], writes[j] = writes[j], writes[i]
+		}
+	}
+	return
+}
+
+// Set implements DBWriter.
+func (tx *dbWriter) Set(key []byte, value []byte) error {
+	if tx.snapshot == nil {
+		return db.ErrTransactionClosed
+	}
+	if err := dbutil.ValidateKv(key, value); err != nil {
+		return err
+	}
+	tx.writes.ReplaceOrInsert(&writeItem{
+		key:   append([]byte{}, key...),
+		value: append([]byte{}, value...),
+	})
+	return nil
+}
+
+// Delete implements DBWriter.
+func (tx *dbWriter) Delete(key []byte) error {
+	if tx.snapshot == nil {
+		return db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return db.ErrKeyEmpty
+	}
+	tx.writes.ReplaceOrInsert(&writeItem{key: append([]byte{}, key...), deleted: true})
+	return nil
+}
+
+// Commit implements DBWriter. It fails with ErrConflict if a key read or written by the
+// transaction, or a key in a range it iterated, has been written by another transaction since it
+// was opened.
+func (tx *dbWriter) Commit() (err error) {
+	if tx.snapshot == nil {
+		return db.ErrTransactionClosed
+	}
+	defer func() { err = dbutil.CombineErrors(err, tx.Discard(), "Discard also failed") }()
+	batch := tx.mgr.current.NewBatch()
+	defer batch.Close()
+	tx.writes.Ascend(func(i btree.Item) bool {
+		write := i.(*writeItem)
+		if write.deleted {
+			err = batch.Delete(write.key, nil)
+		} else {
+			err = batch.Set(write.key, write.value, nil)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	return tx.mgr.conflicts.commit(batch, tx.startSeq, tx.reads, tx.readRanges)
+}
+
+// Discard implements DBWriter.
+func (tx *dbWriter) Discard() error {
+	if tx.snapshot == nil {
+		return nil // Discard() is idempotent
+	}
+	defer func() {
+		tx.snapshot = nil
+		tx.writes = nil
+		tx.mgr.conflicts.end()
+		atomic.AddInt32(&tx.mgr.openWriters, -1)
+	}()
+	return tx.snapshot.Close()
+}
+
+// Returns a copy of the value at a key, or nil if it does not exist.
+func get(reader pebbleReader, key []byte) ([]byte, error) {
+	res, closer, err := reader.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return append([]byte{}, res...), nil
+}
+
+// Registers an open read-write transaction and returns the current commit sequence number.
+func (ct *conflictTracker) begin() uint64 {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+	ct.openTxns++
+	return ct.seq
+}
+
+// Unregisters a read-write transaction. Written keys are only tracked while transactions are open.
+func (ct *conflictTracker) end() {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+	ct.openTxns--
+	if ct.openTxns == 0 {
+		ct.written = map[string]uint64{}
+	}
+}
+
+// Applies a batch to the DB. If reads is not nil, the batch belongs to a read-write transaction
+// opened at commit sequence number startSeq, and is rejected if any of the keys it read or wrote,
+// or any key in the ranges it iterated, have been written since.
+func (ct *conflictTracker) commit(
+	batch *pebble.Batch, startSeq uint64, reads map[string]struct{}, readRanges []keyRange,
+) error {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+	if reads != nil {
+		for key := range reads {
+			if ct.written[key] > startSeq {
+				return ErrConflict
+			}
+		}
+		if len(readRanges) > 0 {
+			for key, seq := range ct.written {
+				if seq <= startSeq {
+					continue
+				}
+				for _, r := range readRanges {
+					if r.contains([]byte(key)) {
+						return ErrConflict
+					}
+				}
+			}
+		}
+		for r := batch.Reader(); ; {
+			_, key, _, ok := r.Next()
+			if !ok {
+				break
+			}
+			if ct.written[string(key)] > startSeq {
+				return ErrConflict
+			}
+		}
+	}
+	if err := batch.Commit(pebble.NoSync); err != nil {
+		return err
+	}
+	ct.seq++
+	if ct.openTxns > 0 {
+		for r := batch.Reader(); ; {
+			_, key, _, ok := r.Next()
+			if !ok {
+				break
+			}
+			ct.written[string(key)] = ct.seq
+		}
+	}
+	return nil
+}
+
+// Opens the checkpoint of a version in the given checkpoints directory, or returns the cached
+// connection to it, which is closed once each caller has decremented its open count.
+func (cpc *checkpointCache) open(checkpointsDir string, ver uint64, opts *pebble.Options) (*pebble.DB, error) {
+	cpc.mtx.Lock()
+	defer cpc.mtx.Unlock()
+	cp, has := cpc.cache[ver]
+	if has {
+		cp.openCount += 1
+		return cp.cxn, nil
+	}
+	dbPath, err := checkpointPath(checkpointsDir, ver)
+	if err != nil {
+		return nil, err
+	}
+	db, err := pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	cpc.cache[ver] = &cpCacheEntry{cxn: db, openCount: 1}
+	return db, nil
+}
+
+func (cpc *checkpointCache) has(ver uint64) bool {
+	cpc.mtx.RLock()
+	defer cpc.mtx.RUnlock()
+	_, has := cpc.cache[ver]
+	return has
+}
+
+func (cpc *checkpointCache) decrement(ver uint64) bool {
+	cpc.mtx.Lock()
+	defer cpc.mtx.Unlock()
+	cp, has := cpc.cache[ver]
+	if !has {
+		return false
+	}
+	cp.openCount -= 1
+	if cp.openCount == 0 {
+		cp.cxn.Close()
+		delete(cpc.cache, ver)
+	}
+	return true
+}
+
+// Closes all the cached checkpoint connections, whether or not they are still in use.
+func (cpc *checkpointCache) close() (err error) {
+	cpc.mtx.Lock()
+	defer cpc.mtx.Unlock()
+	for ver, cp := range cpc.cache {
+		err = dbutil.CombineErrors(err, cp.cxn.Close(), "checkpoint Close also failed")
+		delete(cpc.cache, ver)
+	}
+	return
+}
+
+// Less implements btree.Item.
+func (i *writeItem) Less(other btree.Item) bool {
+	return bytes.Compare(i.key, other.(*writeItem).key) < 0
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
@@ -0,0 +1,289 @@
+package pebbledb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/dbtest"
+)
+
+func BenchmarkPebbleDBRangeScans1M(b *testing.B) {
+	dbm, err := NewDB(b.TempDir())
+	require.NoError(b, err)
+	defer dbm.Close()
+
+	dbtest.BenchmarkRangeScans(b, dbm.ReadWriter(), int64(1e6))
+}
+
+func BenchmarkPebbleDBRandomReadsWrites(b *testing.B) {
+	dbm, err := NewDB(b.TempDir())
+	require.NoError(b, err)
+	defer dbm.Close()
+
+	dbtest.BenchmarkRandomReadsWrites(b, dbm.ReadWriter())
+}
+
+func load(t *testing.T, dir string) db.DBConnection {
+	d, err := NewDB(dir)
+	require.NoError(t, err)
+	return d
+}
+
+func TestGetSetHasDelete(t *testing.T) {
+	dbtest.DoTestGetSetHasDelete(t, load)
+}
+
+func TestIterators(t *testing.T) {
+	dbtest.DoTestIterators(t, load)
+}
+
+func TestTransactions(t *testing.T) {
+	dbtest.DoTestTransactions(t, load, true)
+}
+
+func TestVersioning(t *testing.T) {
+	dbtest.DoTestVersioning(t, load)
+}
+
+func TestRevert(t *testing.T) {
+	dbtest.DoTestRevert(t, load, false)
+	dbtest.DoTestRevert(t, load, true)
+}
+
+func TestReloadDB(t *testing.T) {
+	dbtest.DoTestReloadDB(t, load)
+}
+
+// Test that a read-write transaction conflicts with writes to the keys it wrote, as well as read
+func TestWriteConflicts(t *testing.T) {
+	d, err := NewDB(t.TempDir())
+	require.NoError(t, err)
+	defer d.Close()
+
+	tx1, tx2 := d.Writer(), d.ReadWriter()
+	require.NoError(t, tx1.Set([]byte("a"), []byte("1")))
+	require.NoError(t, tx2.Set([]byte("a"), []byte("2")))
+	require.NoError(t, tx1.Commit())
+	require.Equal(t, ErrConflict, tx2.Commit())
+
+	// Transactions opened after a commit do not conflict with it
+	tx3 := d.ReadWriter()
+	val, err := tx3.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), val)
+	require.NoError(t, tx3.Set([]byte("a"), []byte("3")))
+	require.NoError(t, tx3.Commit())
+
+	// Disjoint transactions do not conflict
+	tx4, tx5 := d.ReadWriter(), d.ReadWriter()
+	require.NoError(t, tx4.Set([]byte("b"), []byte("4")))
+	require.NoError(t, tx5.Set([]byte("c"), []byte("5")))
+	require.NoError(t, tx4.Commit())
+	require.NoError(t, tx5.Commit())
+}
+
+// Test that a read-write transaction reads the DB as of when it was opened, and conflicts with
+// writes to the ranges it iterated
+func TestSnapshotIsolation(t *testing.T) {
+	d, err := NewDB(t.TempDir())
+	require.NoError(t, err)
+	defer d.Close()
+
+	txn := d.Writer()
+	require.NoError(t, txn.Set([]byte("a"), []byte("1")))
+	require.NoError(t, txn.Set([]byte("c"), []byte("1")))
+	require.NoError(t, txn.Commit())
+
+	tx1 := d.ReadWriter()
+	txn = d.Writer()
+	require.NoError(t, txn.Set([]byte("a"), []byte("2")))
+	require.NoError(t, txn.Set([]byte("b"), []byte("2")))
+	require.NoError(t, txn.Commit())
+
+	// Commits made after the transaction was opened are not read
+	val, err := tx1.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), val)
+	has, err := tx1.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// The transaction's own writes are read in place of the snapshot's, in both directions
+	require.NoError(t, tx1.Set([]byte("c"), []byte("3")))
+	require.NoError(t, tx1.Set([]byte("d"), []byte("3")))
+	require.NoError(t, tx1.Delete([]byte("a")))
+	for _, reverse := range []bool{false, true} {
+		var itr db.Iterator
+		if reverse {
+			itr, err = tx1.ReverseIterator(nil, nil)
+		} else {
+			itr, err = tx1.Iterator(nil, nil)
+		}
+		require.NoError(t, err)
+		var kvs []string
+		for itr.Next() {
+			kvs = append(kvs, string(itr.Key())+string(itr.Value()))
+		}
+		require.NoError(t, itr.Close())
+		if reverse {
+			require.Equal(t, []string{"d3", "c3"}, kvs)
+		} else {
+			require.Equal(t, []string{"c3", "d3"}, kvs)
+		}
+	}
+	// It read "a", which has been written since
+	require.Equal(t, ErrConflict, tx1.Commit())
+
+	// A transaction conflicts with a key written in a range it iterated, even if it did not exist
+	tx2 := d.ReadWriter()
+	itr, err := tx2.Iterator([]byte("e"), []byte("g"))
+	require.NoError(t, err)
+	require.False(t, itr.Next())
+	require.NoError(t, itr.Close())
+	require.NoError(t, tx2.Set([]byte("x"), []byte("4")))
+	txn = d.Writer()
+	require.NoError(t, txn.Set([]byte("f"), []byte("4")))
+	require.NoError(t, txn.Commit())
+	require.Equal(t, ErrConflict, tx2.Commit())
+
+	// But not with keys written outside of it
+	tx3 := d.ReadWriter()
+	itr, err = tx3.Iterator([]byte("e"), []byte("g"))
+	require.NoError(t, err)
+	require.NoError(t, itr.Close())
+	require.NoError(t, tx3.Set([]byte("x"), []byte("5")))
+	txn = d.Writer()
+	require.NoError(t, txn.Set([]byte("g"), []byte("5")))
+	require.NoError(t, txn.Commit())
+	require.NoError(t, tx3.Commit())
+}
+
+// Test that closing the DB closes the checkpoints still open for reading
+func TestCloseOpenCheckpoints(t *testing.T) {
+	d, err := NewDB(t.TempDir())
+	require.NoError(t, err)
+	txn := d.Writer()
+	require.NoError(t, txn.Set([]byte("a"), []byte("1")))
+	require.NoError(t, txn.Commit())
+	ver, err := d.SaveNextVersion()
+	require.NoError(t, err)
+
+	_, err = d.ReaderAt(ver)
+	require.NoError(t, err)
+	require.True(t, d.cpCache.has(ver))
+	require.NoError(t, d.Close())
+	require.False(t, d.cpCache.has(ver))
+}
+
+// Test that the DB can be reloaded after a failed Revert
+func TestRevertRecovery(t *testing.T) {
+	dir := t.TempDir()
+	d, err := NewDB(dir)
+	require.NoError(t, err)
+	txn := d.Writer()
+	require.NoError(t, txn.Set([]byte{1}, []byte{1}))
+	require.NoError(t, txn.Commit())
+	_, err = d.SaveNextVersion()
+	require.NoError(t, err)
+	txn = d.Writer()
+	require.NoError(t, txn.Set([]byte{2}, []byte{2}))
+	require.NoError(t, txn.Commit())
+
+	// move checkpoints dir temporarily to trigger an error
+	hideDir := filepath.Join(dir, "hide_checkpoints")
+	require.NoError(t, os.Rename(d.checkpointsDir(), hideDir))
+	require.Error(t, d.Revert())
+	require.NoError(t, os.Rename(hideDir, d.checkpointsDir()))
+
+	d, err = NewDB(dir)
+	require.NoError(t, err)
+	view := d.Reader()
+	val, err := view.Get([]byte{1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, val)
+	val, err = view.Get([]byte{2})
+	require.NoError(t, err)
+	require.Nil(t, val)
+	view.Discard()
+	require.NoError(t, d.Close())
+}
+
+// Test that a read-only connection follows the versions saved by the writer
+func TestReadOnlyDB(t *testing.T) {
+	dir := t.TempDir()
+	_, err := NewReadOnlyDB(dir)
+	require.Error(t, err)
+
+	writer, err := NewDB(dir)
+	require.NoError(t, err)
+	defer writer.Close()
+	reader, err := NewReadOnlyDB(dir)
+	require.NoError(t, err)
+
+	write := func(value []byte) uint64 {
+		txn := writer.Writer()
+		require.NoError(t, txn.Set([]byte("k"), value))
+		require.NoError(t, txn.Commit())
+		version, err := writer.SaveNextVersion()
+		require.NoError(t, err)
+		return version
+	}
+	_, err = reader.Reader().Get([]byte("k"))
+	require.ErrorIs(t, err, db.ErrVersionDoesNotExist)
+
+	v1 := write([]byte("v1"))
+	// the writer reading the checkpoint does not prevent the reader from opening it
+	writerView, err := writer.ReaderAt(v1)
+	require.NoError(t, err)
+	defer writerView.Discard()
+	view, err := reader.ReaderAt(v1)
+	require.NoError(t, err)
+	val, err := view.Get([]byte("k"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), val)
+	require.NoError(t, view.Discard())
+
+	// uncommitted and unsaved writes are not visible
+	txn := writer.Writer()
+	require.NoError(t, txn.Set([]byte("k"), []byte("unsaved")))
+	require.NoError(t, txn.Commit())
+	v1View := reader.Reader()
+	val, err = v1View.Get([]byte("k"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), val)
+
+	v2 := write([]byte("v2"))
+	versions, err := reader.Versions()
+	require.NoError(t, err)
+	require.Equal(t, v2, versions.Last())
+	require.Equal(t, 2, versions.Count())
+	view = reader.Reader()
+	val, err = view.Get([]byte("k"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), val)
+	require.NoError(t, view.Discard())
+
+	// versions deleted by the writer are no longer available, even if still read by v1View
+	require.NoError(t, writerView.Discard())
+	require.NoError(t, writer.DeleteVersion(v1))
+	versions, err = reader.Versions()
+	require.NoError(t, err)
+	require.False(t, versions.Exists(v1))
+	_, err = reader.ReaderAt(v1)
+	require.ErrorIs(t, err, db.ErrVersionDoesNotExist)
+
+	rw := reader.ReadWriter()
+	require.ErrorIs(t, rw.Set([]byte("k"), []byte("v")), db.ErrReadOnly)
+	require.ErrorIs(t, rw.Commit(), db.ErrReadOnly)
+	require.ErrorIs(t, reader.SaveVersion(v2+1), db.ErrReadOnly)
+	require.ErrorIs(t, reader.DeleteVersion(v2), db.ErrReadOnly)
+
+	// transactions left open, e.g. v1View, are closed along with the connection
+	_, err = reader.ReaderAt(v2)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+}
@@ -0,0 +1,177 @@
+package pebbledb
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/cosmos/cosmos-sdk/db"
+)
+
+type pebbleIterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	isReverse  bool
+	// Whether iterator has been advanced to the first element (is fully initialized)
+	primed bool
+}
+
+// Iterates over the snapshot read by a read-write transaction, with the keys written by the
+// transaction in place of the snapshot's.
+type writerIterator struct {
+	source *pebbleIterator
+	// The writes remaining in the iteration range, in iteration order
+	writes []*writeItem
+	// Whether the source has been advanced to its first key
+	primed bool
+	// Whether the source is at a key not yet returned
+	sourceValid bool
+	key, value  []byte
+	valid       bool
+}
+
+var (
+	_ db.Iterator = (*pebbleIterator)(nil)
+	_ db.Iterator = (*writerIterator)(nil)
+)
+
+func newPebbleIterator(reader pebbleReader, start, end []byte, isReverse bool) (*pebbleIterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, db.ErrKeyEmpty
+	}
+	source := reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return &pebbleIterator{
+		source:    source,
+		start:     start,
+		end:       end,
+		isReverse: isReverse,
+	}, nil
+}
+
+// Domain implements Iterator.
+func (itr *pebbleIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Next implements Iterator.
+func (itr *pebbleIterator) Next() bool {
+	if !itr.primed {
+		itr.primed = true
+		if itr.isReverse {
+			return itr.source.Last()
+		}
+		return itr.source.First()
+	}
+	if itr.isReverse {
+		return itr.source.Prev()
+	}
+	return itr.source.Next()
+}
+
+// Key implements Iterator.
+func (itr *pebbleIterator) Key() []byte {
+	itr.assertIsValid()
+	return append([]byte{}, itr.source.Key()...)
+}
+
+// Value implements Iterator.
+func (itr *pebbleIterator) Value() []byte {
+	itr.assertIsValid()
+	return append([]byte{}, itr.source.Value()...)
+}
+
+// Error implements Iterator.
+func (itr *pebbleIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *pebbleIterator) Close() error {
+	return itr.source.Close()
+}
+
+func (itr *pebbleIterator) assertIsValid() {
+	if !itr.primed || !itr.source.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+func newWriterIterator(source *pebbleIterator, writes []*writeItem) *writerIterator {
+	return &writerIterator{source: source, writes: writes}
+}
+
+// Domain implements Iterator.
+func (itr *writerIterator) Domain() ([]byte, []byte) {
+	return itr.source.Domain()
+}
+
+// Next implements Iterator.
+func (itr *writerIterator) Next() bool {
+	if !itr.primed {
+		itr.primed = true
+		itr.sourceValid = itr.source.Next()
+	}
+	for itr.sourceValid || len(itr.writes) > 0 {
+		var write *writeItem
+		if len(itr.writes) > 0 {
+			if !itr.sourceValid {
+				write = itr.writes[0]
+			} else {
+				cmp := bytes.Compare(itr.writes[0].key, itr.source.source.Key())
+				if itr.source.isReverse {
+					cmp = -cmp
+				}
+				if cmp <= 0 {
+					write = itr.writes[0]
+				}
+				// The snapshot's value is overwritten
+				if cmp == 0 {
+					itr.sourceValid = itr.source.Next()
+				}
+			}
+		}
+		if write == nil {
+			itr.key, itr.value = itr.source.Key(), itr.source.Value()
+			itr.sourceValid = itr.source.Next()
+			itr.valid = true
+			return true
+		}
+		itr.writes = itr.writes[1:]
+		if !write.deleted {
+			itr.key = append([]byte{}, write.key...)
+			itr.value = append([]byte{}, write.value...)
+			itr.valid = true
+			return true
+		}
+	}
+	itr.valid = false
+	return false
+}
+
+// Key implements Iterator.
+func (itr *writerIterator) Key() []byte {
+	itr.assertIsValid()
+	return itr.key
+}
+
+// Value implements Iterator.
+func (itr *writerIterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.value
+}
+
+// Error implements Iterator.
+func (itr *writerIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *writerIterator) Close() error {
+	return itr.source.Close()
+}
+
+func (itr *writerIterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
@@ -0,0 +1,166 @@
+package pebbledb
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble/vfs"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	dbutil "github.com/cosmos/cosmos-sdk/db/internal"
+)
+
+var _ db.DBConnection = (*ReadOnlyDB)(nil)
+
+// ReadOnlyDB is a read-only connection to the versions saved by a PebbleDB, which may be open for
+// writing in another process. The saved versions are listed from the checkpoints directory on each
+// access, so that the versions saved and deleted by the writer are followed. Only saved versions
+// can be read: Reader reads the latest one, and all writes fail with ErrReadOnly.
+type ReadOnlyDB struct {
+	dir     string
+	cpCache checkpointCache
+}
+
+// Checkpoints are never written once saved, so they are opened without taking the lock of their
+// directory, which the writer may hold while reading them.
+type lockFreeFS struct {
+	vfs.FS
+}
+
+type nopCloser struct{}
+
+// Wraps a reader of the latest saved version, failing all writes.
+type readOnlyWriter struct {
+	db.DBReader
+}
+
+// Fails all operations with an error, e.g. when no version is saved.
+type errReader struct {
+	err error
+}
+
+// NewReadOnlyDB opens a read-only connection to the PebbleDB in the given directory, which must
+// exist.
+func NewReadOnlyDB(dir string) (*ReadOnlyDB, error) {
+	mgr := &ReadOnlyDB{
+		dir:     dir,
+		cpCache: checkpointCache{cache: map[uint64]*cpCacheEntry{}},
+	}
+	if _, err := os.Stat(mgr.checkpointsDir()); err != nil {
+		return nil, fmt.Errorf("%s is not a pebble DB saving versions: %w", dir, err)
+	}
+	return mgr, nil
+}
+
+func (mgr *ReadOnlyDB) checkpointsDir() string {
+	return filepath.Join(mgr.dir, "checkpoints")
+}
+
+// Reader implements DBConnection. It reads the latest saved version.
+func (mgr *ReadOnlyDB) Reader() db.DBReader {
+	versions, err := mgr.Versions()
+	if err != nil {
+		return errReader{err}
+	}
+	if versions.Last() == 0 {
+		return errReader{db.ErrVersionDoesNotExist}
+	}
+	reader, err := mgr.ReaderAt(versions.Last())
+	if err != nil {
+		return errReader{err}
+	}
+	return reader
+}
+
+// ReaderAt implements DBConnection.
+func (mgr *ReadOnlyDB) ReaderAt(version uint64) (db.DBReader, error) {
+	// The writer may have deleted the version since its checkpoint was cached
+	if _, err := checkpointPath(mgr.checkpointsDir(), version); err != nil {
+		return nil, err
+	}
+	opts := newOptions(true)
+	opts.FS = lockFreeFS{opts.FS}
+	d, err := mgr.cpCache.open(mgr.checkpointsDir(), version, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &dbTxn{reader: d, cpCache: &mgr.cpCache, version: version}, nil
+}
+
+// ReadWriter implements DBConnection. The returned transaction reads the latest saved version, and
+// fails all writes with ErrReadOnly.
+func (mgr *ReadOnlyDB) ReadWriter() db.DBReadWriter {
+	return readOnlyWriter{mgr.Reader()}
+}
+
+// Writer implements DBConnection. The returned transaction fails all writes with ErrReadOnly.
+func (mgr *ReadOnlyDB) Writer() db.DBWriter {
+	return readOnlyWriter{errReader{db.ErrReadOnly}}
+}
+
+// Versions implements DBConnection.
+func (mgr *ReadOnlyDB) Versions() (db.VersionSet, error) {
+	return readVersions(mgr.checkpointsDir())
+}
+
+// SaveNextVersion implements DBConnection.
+func (mgr *ReadOnlyDB) SaveNextVersion() (uint64, error) {
+	return 0, db.ErrReadOnly
+}
+
+// SaveVersion implements DBConnection.
+func (mgr *ReadOnlyDB) SaveVersion(uint64) error {
+	return db.ErrReadOnly
+}
+
+// DeleteVersion implements DBConnection.
+func (mgr *ReadOnlyDB) DeleteVersion(uint64) error {
+	return db.ErrReadOnly
+}
+
+// Revert implements DBConnection.
+func (mgr *ReadOnlyDB) Revert() error {
+	return db.ErrReadOnly
+}
+
+// Close implements DBConnection. It closes the checkpoints opened by transactions which have not
+// been discarded.
+func (mgr *ReadOnlyDB) Close() error {
+	return mgr.cpCache.close()
+}
+
+// Lock implements vfs.FS.
+func (lockFreeFS) Lock(string) (io.Closer, error) {
+	return nopCloser{}, nil
+}
+
+// Close implements io.Closer.
+func (nopCloser) Close() error { return nil }
+
+// Set implements DBWriter.
+func (readOnlyWriter) Set([]byte, []byte) error { return db.ErrReadOnly }
+
+// Delete implements DBWriter.
+func (readOnlyWriter) Delete([]byte) error { return db.ErrReadOnly }
+
+// Commit implements DBWriter. The transaction is discarded.
+func (tx readOnlyWriter) Commit() error {
+	return dbutil.CombineErrors(db.ErrReadOnly, tx.Discard(), "Discard also failed")
+}
+
+// Get implements DBReader.
+func (r errReader) Get([]byte) ([]byte, error) { return nil, r.err }
+
+// Has implements DBReader.
+func (r errReader) Has([]byte) (bool, error) { return false, r.err }
+
+// Iterator implements DBReader.
+func (r errReader) Iterator(_, _ []byte) (db.Iterator, error) { return nil, r.err }
+
+// ReverseIterator implements DBReader.
+func (r errReader) ReverseIterator(_, _ []byte) (db.Iterator, error) { return nil, r.err }
+
+// Discard implements DBReader.
+func (errReader) Discard() error { return nil }
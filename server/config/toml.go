@@ -76,6 +76,11 @@ index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 # Default cache size is 50mb.
 iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 
+# AccessTracingBlocks defines the number of recent blocks for which the keys
+# accessed by each delivered transaction are kept, to be queried from the debug
+# gRPC service. 0 disables access tracing.
+access-tracing-blocks = {{ .BaseConfig.AccessTracingBlocks }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
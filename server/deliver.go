@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+
+	abciclient "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// txsDeliverer is implemented by applications which deliver the txs of a block at once, e.g.
+// baseapp.BaseApp.DeliverTxs, which executes them in parallel if enabled.
+type txsDeliverer interface {
+	DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx
+}
+
+// newLocalCreator returns a creator of local ABCI clients to an application. If bufferTxs is set
+// and the application delivers the txs of a block at once, the txs passed to DeliverTxAsync are
+// buffered until EndBlock, where they are delivered together before the block is ended.
+// Tendermint delivers the txs of a block with DeliverTxAsync and only reads their responses once
+// EndBlock returns.
+func newLocalCreator(app abci.Application, bufferTxs bool) abciclient.Creator {
+	deliverer, ok := app.(txsDeliverer)
+	if !bufferTxs || !ok {
+		return abciclient.NewLocalCreator(app)
+	}
+
+	bufferedApp := &blockBufferApp{Application: app, deliverer: deliverer}
+	creator := abciclient.NewLocalCreator(bufferedApp)
+	return func() (abciclient.Client, error) {
+		client, err := creator()
+		if err != nil {
+			return nil, err
+		}
+		return &blockBufferClient{Client: client, app: bufferedApp}, nil
+	}
+}
+
+// blockBufferApp delivers the txs buffered by a blockBufferClient when the block is ended. Its
+// methods are called by the local clients while holding the lock they share, so the txs are
+// delivered under the same lock as any other ABCI call.
+type blockBufferApp struct {
+	abci.Application
+	deliverer txsDeliverer
+
+	// the txs of the current block, and their responses once the block is ended
+	reqs []*abciclient.ReqRes
+}
+
+// EndBlock implements the ABCI interface.
+func (app *blockBufferApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	if len(app.reqs) > 0 {
+		deliverReqs := make([]abci.RequestDeliverTx, len(app.reqs))
+		for i, reqRes := range app.reqs {
+			deliverReqs[i] = *reqRes.Request.GetDeliverTx()
+		}
+		for i, res := range app.deliverer.DeliverTxs(deliverReqs) {
+			app.reqs[i].Response = abci.ToResponseDeliverTx(res)
+		}
+	}
+
+	return app.Application.EndBlock(req)
+}
+
+// blockBufferClient is a local ABCI client buffering the txs passed to DeliverTxAsync, which are
+// delivered by a blockBufferApp in EndBlock. Only the consensus connection delivers txs, so the
+// buffer is never accessed concurrently.
+type blockBufferClient struct {
+	abciclient.Client
+	app *blockBufferApp
+
+	callback abciclient.Callback
+}
+
+// SetResponseCallback implements the Client interface.
+func (c *blockBufferClient) SetResponseCallback(cb abciclient.Callback) {
+	c.callback = cb
+	c.Client.SetResponseCallback(cb)
+}
+
+// DeliverTxAsync implements the Client interface. The tx is delivered, and the response callbacks
+// invoked, once the block is ended.
+func (c *blockBufferClient) DeliverTxAsync(_ context.Context, req abci.RequestDeliverTx) (*abciclient.ReqRes, error) {
+	reqRes := abciclient.NewReqRes(abci.ToRequestDeliverTx(req))
+	c.app.reqs = append(c.app.reqs, reqRes)
+	return reqRes, nil
+}
+
+// EndBlockAsync implements the Client interface.
+func (c *blockBufferClient) EndBlockAsync(ctx context.Context, req abci.RequestEndBlock) (*abciclient.ReqRes, error) {
+	reqRes, err := c.Client.EndBlockAsync(ctx, req)
+	c.invokeCallbacks()
+	return reqRes, err
+}
+
+// EndBlockSync implements the Client interface.
+func (c *blockBufferClient) EndBlockSync(ctx context.Context, req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	res, err := c.Client.EndBlockSync(ctx, req)
+	c.invokeCallbacks()
+	return res, err
+}
+
+// invokeCallbacks passes the responses of the txs delivered in EndBlock to the response callbacks
+// and empties the buffer.
+func (c *blockBufferClient) invokeCallbacks() {
+	reqs := c.app.reqs
+	c.app.reqs = nil
+	for _, reqRes := range reqs {
+		if reqRes.Response == nil {
+			continue
+		}
+		if c.callback != nil {
+			c.callback(reqRes.Request, reqRes.Response)
+		}
+		reqRes.SetDone()
+		reqRes.Done()
+		reqRes.InvokeCallback()
+	}
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// blockApp records the txs it delivers, one by one or a block at once.
+type blockApp struct {
+	abci.BaseApplication
+
+	delivered [][]byte
+	blocks    int
+}
+
+func (app *blockApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	app.delivered = append(app.delivered, req.Tx)
+	return abci.ResponseDeliverTx{Data: req.Tx}
+}
+
+func (app *blockApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	app.blocks++
+	ress := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		ress[i] = app.DeliverTx(req)
+	}
+	return ress
+}
+
+func TestLocalCreatorBufferTxs(t *testing.T) {
+	ctx := context.Background()
+	txs := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}
+
+	for _, bufferTxs := range []bool{false, true} {
+		app := &blockApp{}
+		client, err := newLocalCreator(app, bufferTxs)()
+		require.NoError(t, err)
+
+		var responses [][]byte
+		client.SetResponseCallback(func(_ *abci.Request, res *abci.Response) {
+			if res := res.GetDeliverTx(); res != nil {
+				responses = append(responses, res.Data)
+			}
+		})
+
+		_, err = client.BeginBlockSync(ctx, abci.RequestBeginBlock{})
+		require.NoError(t, err)
+		for _, tx := range txs {
+			_, err = client.DeliverTxAsync(ctx, abci.RequestDeliverTx{Tx: tx})
+			require.NoError(t, err)
+		}
+
+		if bufferTxs {
+			// the txs are delivered together once the block is ended
+			require.Empty(t, app.delivered)
+			require.Empty(t, responses)
+		} else {
+			require.Equal(t, txs, app.delivered)
+		}
+
+		_, err = client.EndBlockSync(ctx, abci.RequestEndBlock{})
+		require.NoError(t, err)
+		require.Equal(t, txs, app.delivered)
+		require.Equal(t, txs, responses)
+		if bufferTxs {
+			require.Equal(t, 1, app.blocks)
+		} else {
+			require.Zero(t, app.blocks)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/db/pebbledb"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	multi "github.com/cosmos/cosmos-sdk/store/v2/multi"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetQueryMultiStore returns a multistore serving queries from the versions saved by a node
+// running in another process if the node is started with the --query-only flag, and nil otherwise.
+// Apps must set it with baseapp.SetQueryMultiStore.
+//
+// The state of the node followed must be a store/v2/multi.Store backed by a db/pebbledb DB, in the
+// directory set with the --query-db flag, by default application.db in the data directory of the
+// application home. The versions it saves are checkpoints, which are read while the node is
+// running, and the versions it saves and prunes are followed.
+func GetQueryMultiStore(appOpts types.AppOptions) (sdk.QueryMultiStore, error) {
+	if !cast.ToBool(appOpts.Get(FlagQueryOnly)) {
+		return nil, nil
+	}
+
+	dir := cast.ToString(appOpts.Get(FlagQueryDB))
+	if dir == "" {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "application.db")
+	}
+	db, err := pebbledb.NewReadOnlyDB(dir)
+	if err != nil {
+		return nil, err
+	}
+	store, err := multi.NewReadOnlyStore(db, multi.DefaultStoreConfig())
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
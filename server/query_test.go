@@ -0,0 +1,69 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/db/pebbledb"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	multi "github.com/cosmos/cosmos-sdk/store/v2/multi"
+)
+
+func TestGetQueryMultiStore(t *testing.T) {
+	home := t.TempDir()
+	key := storetypes.NewKVStoreKey("store")
+
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, home)
+	qms, err := GetQueryMultiStore(appOpts)
+	require.NoError(t, err)
+	require.Nil(t, qms)
+
+	// the application DB must have been created by the node followed
+	appOpts.Set(FlagQueryOnly, true)
+	_, err = GetQueryMultiStore(appOpts)
+	require.Error(t, err)
+
+	db, err := pebbledb.NewDB(filepath.Join(home, "data", "application.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	opts := multi.DefaultStoreConfig()
+	require.NoError(t, opts.RegisterSubstore(key.Name(), storetypes.StoreTypePersistent))
+	writer, err := multi.NewStore(db, opts)
+	require.NoError(t, err)
+	writer.GetKVStore(key).Set([]byte("key"), []byte("value1"))
+	writer.Commit()
+
+	// the versions are served while the node writing them is running
+	qms, err = GetQueryMultiStore(appOpts)
+	require.NoError(t, err)
+	app := baseapp.NewBaseApp("query", log.NewNopLogger(), dbm.NewMemDB(), baseapp.SetQueryMultiStore(qms))
+	require.NoError(t, app.LoadLatestVersion())
+
+	query := abci.RequestQuery{Path: "/store/store/key", Data: []byte("key")}
+	res := app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, []byte("value1"), res.Value)
+
+	// and the versions it saves are followed
+	writer.GetKVStore(key).Set([]byte("key"), []byte("value2"))
+	writer.Commit()
+	res = app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(2), res.Height)
+	require.Equal(t, []byte("value2"), res.Value)
+
+	query.Height = 1
+	res = app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("value1"), res.Value)
+}
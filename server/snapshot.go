@@ -0,0 +1,389 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagOutput is the output path of a snapshot archive.
+	FlagOutput = "output"
+
+	// snapshotArchiveMetadata is the name of the snapshot metadata entry in a snapshot archive,
+	// which is followed by an entry for each chunk named by its index.
+	snapshotArchiveMetadata = "metadata"
+)
+
+// GetSnapshotStore opens the local snapshot store in the data directory of the application home.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	return openSnapshotStore(cast.ToString(appOpts.Get(flags.FlagHome)))
+}
+
+func openSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
+// SnapshotCmd returns the snapshots command, which manages the local state sync snapshots of a
+// stopped node. Snapshots can be dumped to and loaded from archive files, which allows seeding
+// new nodes without networked peers.
+func SnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+	}
+
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		ExportSnapshotCmd(appCreator),
+		RestoreSnapshotCmd(appCreator),
+		DumpSnapshotCmd(),
+		LoadSnapshotCmd(),
+		DeleteSnapshotCmd(),
+	)
+
+	return cmd
+}
+
+// ListSnapshotsCmd lists the local snapshots.
+func ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openSnapshotStore(GetServerContextFromCmd(cmd).Config.RootDir)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := store.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				cmd.Printf("height: %d format: %d chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			}
+			return nil
+		},
+	}
+}
+
+// ExportSnapshotCmd creates a snapshot of the application state in the local snapshot store.
+func ExportSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the application state to a local snapshot",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			db, err := openDB(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = app.Info(abci.RequestInfo{}).LastBlockHeight
+			}
+			if height <= 0 {
+				return fmt.Errorf("invalid snapshot height %d", height)
+			}
+
+			cmd.Printf("Exporting snapshot at height %d...\n", height)
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return fmt.Errorf("failed to export snapshot: %w", err)
+			}
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Height to export the snapshot at (0 means latest height)")
+
+	return cmd
+}
+
+// RestoreSnapshotCmd restores the application state from a local snapshot.
+func RestoreSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a local snapshot. The application state must be
+empty. Only the application state is restored, the Tendermint state and block store must be
+bootstrapped separately before starting the node.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			serverCtx := GetServerContextFromCmd(cmd)
+
+			db, err := openDB(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			if err = app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+			cmd.Printf("Restored snapshot at height %d, format %d\n", height, format)
+			return nil
+		},
+	}
+}
+
+// DumpSnapshotCmd writes a local snapshot to a gzip-compressed tar archive.
+func DumpSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot to an archive file",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := openSnapshotStore(GetServerContextFromCmd(cmd).Config.RootDir)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(FlagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+			if err = dumpSnapshot(store, height, format, output); err != nil {
+				return err
+			}
+			cmd.Printf("Snapshot at height %d, format %d dumped to %s\n", height, format, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(FlagOutput, "o", "", "Output archive path (default <height>-<format>.tar.gz)")
+
+	return cmd
+}
+
+// LoadSnapshotCmd loads a snapshot archive into the local snapshot store.
+func LoadSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive>",
+		Short: "Load a snapshot archive file into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openSnapshotStore(GetServerContextFromCmd(cmd).Config.RootDir)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := loadSnapshot(store, args[0])
+			if err != nil {
+				return err
+			}
+			cmd.Printf("Loaded snapshot at height %d, format %d, chunks %d\n",
+				snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+// DeleteSnapshotCmd deletes a local snapshot.
+func DeleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := openSnapshotStore(GetServerContextFromCmd(cmd).Config.RootDir)
+			if err != nil {
+				return err
+			}
+
+			return store.Delete(height, format)
+		},
+	}
+}
+
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot height %q: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid snapshot format %q: %w", args[1], err)
+	}
+	return height, uint32(format), nil
+}
+
+// dumpSnapshot writes a snapshot archive, containing the snapshot metadata followed by each chunk.
+func dumpSnapshot(store *snapshots.Store, height uint64, format uint32, path string) error {
+	snapshot, err := store.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d, format %d not found", height, format)
+	}
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	gzWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzWriter)
+
+	if err = writeArchiveEntry(tarWriter, snapshotArchiveMetadata, metadata); err != nil {
+		return err
+	}
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := store.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return fmt.Errorf("snapshot chunk %d not found", i)
+		}
+		data, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot chunk %d: %w", i, err)
+		}
+		if err = writeArchiveEntry(tarWriter, strconv.FormatUint(uint64(i), 10), data); err != nil {
+			return err
+		}
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return err
+	}
+	if err = gzWriter.Close(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, data []byte) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(data)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tarWriter.Write(data)
+	return err
+}
+
+// loadSnapshot imports a snapshot archive written by dumpSnapshot into the store. The chunks are
+// verified against the archived snapshot metadata.
+func loadSnapshot(store *snapshots.Store, path string) (*snapshottypes.Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	gzReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot archive: %w", err)
+	}
+	defer gzReader.Close()
+	tarReader := tar.NewReader(gzReader)
+
+	metadata, err := readArchiveEntry(tarReader, snapshotArchiveMetadata)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &snapshottypes.Snapshot{}
+	if err = proto.Unmarshal(metadata, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata: %w", err)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return nil, fmt.Errorf("snapshot has %d chunk hashes, but %d chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	type saveResult struct {
+		snapshot *snapshottypes.Snapshot
+		err      error
+	}
+	chunks := make(chan io.ReadCloser)
+	chDone := make(chan saveResult, 1)
+	go func() {
+		saved, err := store.Import(snapshot, chunks)
+		chDone <- saveResult{saved, err}
+	}()
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		data, err := readArchiveEntry(tarReader, strconv.FormatUint(uint64(i), 10))
+		if err != nil {
+			// Abort the import by failing the chunk.
+			pr, pw := io.Pipe()
+			pw.CloseWithError(err)
+			chunks <- pr
+			break
+		}
+		chunks <- io.NopCloser(bytes.NewReader(data))
+	}
+	close(chunks)
+
+	done := <-chDone
+	if done.err != nil {
+		return nil, fmt.Errorf("failed to load snapshot: %w", done.err)
+	}
+	return done.snapshot, nil
+}
+
+func readArchiveEntry(tarReader *tar.Reader, name string) ([]byte, error) {
+	header, err := tarReader.Next()
+	if err == io.EOF {
+		return nil, fmt.Errorf("snapshot archive entry %q is missing", name)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot archive: %w", err)
+	}
+	if header.Name != name {
+		return nil, fmt.Errorf("expected snapshot archive entry %q, got %q", name, header.Name)
+	}
+	return io.ReadAll(tarReader)
+}
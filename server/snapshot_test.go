@@ -0,0 +1,73 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func makeSnapshotChunks(chunks [][]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func TestSnapshotArchive(t *testing.T) {
+	source, err := openSnapshotStore(t.TempDir())
+	require.NoError(t, err)
+	snapshot, err := source.Save(3, 2, makeSnapshotChunks([][]byte{{1, 2, 3}, {4, 5, 6}, {7}}))
+	require.NoError(t, err)
+
+	archive := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	require.NoError(t, dumpSnapshot(source, 3, 2, archive))
+	require.Error(t, dumpSnapshot(source, 4, 2, filepath.Join(t.TempDir(), "missing.tar.gz")))
+
+	target, err := openSnapshotStore(t.TempDir())
+	require.NoError(t, err)
+	loaded, err := loadSnapshot(target, archive)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+
+	_, chunks, err := target.Load(3, 2)
+	require.NoError(t, err)
+	contents := [][]byte{}
+	for chunk := range chunks {
+		data, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		contents = append(contents, data)
+	}
+	require.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7}}, contents)
+
+	// Loading the same snapshot again fails
+	_, err = loadSnapshot(target, archive)
+	require.Error(t, err)
+}
+
+func TestSnapshotArchive_Truncated(t *testing.T) {
+	source, err := openSnapshotStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = source.Save(3, 2, makeSnapshotChunks([][]byte{{1, 2, 3}, {4, 5, 6}}))
+	require.NoError(t, err)
+	archive := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	require.NoError(t, dumpSnapshot(source, 3, 2, archive))
+
+	data, err := os.ReadFile(archive)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(archive, data[:len(data)/2], 0o644))
+
+	// Loading a truncated archive fails, without saving the snapshot
+	target, err := openSnapshotStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = loadSnapshot(target, archive)
+	require.Error(t, err)
+	snapshot, err := target.Get(3, 2)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
@@ -10,13 +10,13 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
-	abciclient "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/rpc/client/local"
 	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -45,6 +45,8 @@ const (
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
+	FlagQueryOnly          = "query-only"
+	FlagQueryDB            = "query-db"
 
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
@@ -52,6 +54,9 @@ const (
 	FlagPruningInterval   = "pruning-interval"
 	FlagIndexEvents       = "index-events"
 	FlagMinRetainBlocks   = "min-retain-blocks"
+
+	FlagAccessTracingBlocks = "access-tracing-blocks"
+	FlagParallelTxWorkers   = "parallel-tx-workers"
 )
 
 // GRPC-related flags.
@@ -95,6 +100,12 @@ will not be able to commit subsequent blocks.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+A read-only query node can be run alongside a full node via the '--query-only' flag. It serves gRPC
+and REST queries without Tendermint, from the versions saved by the full node, and follows the versions
+it saves and prunes. The full node's state must be a store/v2 multistore backed by a Pebble DB, whose
+directory is set with the '--query-db' flag. The application must serve queries from the multistore
+returned by server.GetQueryMultiStore via baseapp.SetQueryMultiStore.
 `,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
@@ -114,15 +125,22 @@ which accepts a path for the resulting pprof file.
 			}
 
 			withTM, _ := cmd.Flags().GetBool(flagWithTendermint)
-			if !withTM {
+			queryOnly, _ := cmd.Flags().GetBool(FlagQueryOnly)
+			switch {
+			case queryOnly:
+				serverCtx.Logger.Info("starting query-only node")
+				err = startQueryOnly(serverCtx, clientCtx, appCreator)
+
+			case !withTM:
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
 				return startStandAlone(serverCtx, appCreator)
-			}
 
-			serverCtx.Logger.Info("starting ABCI with Tendermint")
+			default:
+				serverCtx.Logger.Info("starting ABCI with Tendermint")
 
-			// amino is needed here for backwards compatibility of REST routes
-			err = startInProcess(serverCtx, clientCtx, appCreator)
+				// amino is needed here for backwards compatibility of REST routes
+				err = startInProcess(serverCtx, clientCtx, appCreator)
+			}
 			errCode, ok := err.(ErrorCode)
 			if !ok {
 				return err
@@ -151,6 +169,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().Uint64(FlagAccessTracingBlocks, 0, "Number of recent blocks for which the keys accessed by each delivered tx are kept for the debug gRPC service (0 disables access tracing)")
+	cmd.Flags().Int(FlagParallelTxWorkers, 0, "Number of goroutines executing the txs of a block in parallel (values less than 2 disable parallel execution)")
+	cmd.Flags().Bool(FlagQueryOnly, false, "Run a read-only query node serving gRPC and REST queries from the state committed by another process, without Tendermint")
+	cmd.Flags().String(FlagQueryDB, "", "Directory of the Pebble DB written by the node followed by a query-only node (default: application.db in the data directory)")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -256,7 +278,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	tmNode, err := node.New(
 		cfg,
 		ctx.Logger,
-		abciclient.NewLocalCreator(app),
+		newLocalCreator(app, ctx.Viper.GetInt(FlagParallelTxWorkers) > 1),
 		genDoc,
 	)
 	if err != nil {
@@ -395,3 +417,82 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	// Wait for SIGINT or SIGTERM signal
 	return WaitForQuitSignals()
 }
+
+// startQueryOnly runs the gRPC and API servers without Tendermint, serving
+// queries from the state committed by a node running in another process. The
+// application is created with an in-memory DB, as it never commits, and must
+// serve queries from the multistore returned by GetQueryMultiStore.
+func startQueryOnly(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
+	home := ctx.Config.RootDir
+
+	traceWriter, err := openTraceWriter(ctx.Viper.GetString(flagTraceStore))
+	if err != nil {
+		return err
+	}
+
+	config := config.GetConfig(ctx.Viper)
+	if err := config.ValidateBasic(); err != nil {
+		return err
+	}
+	if !config.GRPC.Enable {
+		return fmt.Errorf("query-only node requires the gRPC server to be enabled")
+	}
+
+	app := appCreator(ctx.Logger, dbm.NewMemDB(), traceWriter, ctx.Viper)
+
+	grpcSrv, err := servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+	if err != nil {
+		return err
+	}
+	defer grpcSrv.Stop()
+
+	if config.GRPCWeb.Enable {
+		grpcWebSrv, err := servergrpc.StartGRPCWeb(grpcSrv, config)
+		if err != nil {
+			ctx.Logger.Error("failed to start grpc-web http server: ", err)
+			return err
+		}
+		defer grpcWebSrv.Close()
+	}
+
+	if config.API.Enable {
+		genDoc, err := tmtypes.GenesisDocFromFile(ctx.Config.GenesisFile())
+		if err != nil {
+			return err
+		}
+
+		// Without Tendermint, the API server queries state via the gRPC server.
+		grpcClient, err := grpc.Dial(config.GRPC.Address, grpc.WithInsecure())
+		if err != nil {
+			return err
+		}
+		defer grpcClient.Close()
+
+		clientCtx := clientCtx.
+			WithHomeDir(home).
+			WithChainID(genDoc.ChainID).
+			WithGRPCClient(grpcClient)
+
+		apiSrv := api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+		app.RegisterAPIRoutes(apiSrv, config.API)
+		errCh := make(chan error)
+
+		go func() {
+			if err := apiSrv.Start(config); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(types.ServerStartTime): // assume server started successfully
+		}
+		defer apiSrv.Close()
+	}
+
+	defer ctx.Logger.Info("exiting...")
+
+	// Wait for SIGINT or SIGTERM signal
+	return WaitForQuitSignals()
+}
@@ -279,6 +279,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		UnsafeResetAllCmd(),
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		SnapshotCmd(appCreator),
 		version.NewVersionCommand(),
 	)
 }
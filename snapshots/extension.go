@@ -0,0 +1,259 @@
+package snapshots
+
+import (
+	"bufio"
+	"compress/zlib"
+	"io"
+	"sort"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	extensionChunkSize   = uint64(10e6)
+	extensionBufferSize  = int(extensionChunkSize)
+	extensionMaxItemSize = int(64e6)
+)
+
+// RegisterExtensions registers extension snapshotters with the manager. Their payloads are
+// appended to every snapshot created afterwards, and restored from snapshots containing them.
+func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if name == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extension snapshotter name cannot be empty")
+		}
+		if _, ok := m.extensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrConflict, "duplicate extension snapshotter %q", name)
+		}
+		m.extensions[name] = extension
+	}
+	return nil
+}
+
+// sortedExtensions returns the registered extensions in lexicographical order by name. The
+// caller must hold the mutex.
+func (m *Manager) sortedExtensions() []types.ExtensionSnapshotter {
+	names := make([]string, 0, len(m.extensions))
+	for name := range m.extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	extensions := make([]types.ExtensionSnapshotter, 0, len(names))
+	for _, name := range names {
+		extensions = append(extensions, m.extensions[name])
+	}
+	return extensions
+}
+
+// appendExtensions passes on the multistore chunks, followed by a payload section for each
+// extension in turn. Each section starts on a new chunk. The extension metadata is appended to
+// metadata as sections complete, and is final once the returned channel has been closed.
+func appendExtensions(
+	height uint64, chunks <-chan io.ReadCloser, extensions []types.ExtensionSnapshotter,
+	metadata *[]types.ExtensionMetadata,
+) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		for chunk := range chunks {
+			ch <- chunk
+		}
+		for _, extension := range extensions {
+			sectionChunks := make(chan io.ReadCloser)
+			go writeExtension(height, extension, sectionChunks)
+			count := uint32(0)
+			for chunk := range sectionChunks {
+				ch <- chunk
+				count++
+			}
+			*metadata = append(*metadata, types.ExtensionMetadata{
+				Name:   extension.SnapshotName(),
+				Format: extension.SnapshotFormat(),
+				Chunks: count,
+			})
+		}
+	}()
+	return ch
+}
+
+// writeExtension writes an extension payload section as a zlib-compressed stream of delimited
+// SnapshotItem Protobuf messages: a SnapshotExtensionMeta followed by the extension's
+// SnapshotExtensionPayload items, split into fixed-size chunks.
+func writeExtension(height uint64, extension types.ExtensionSnapshotter, ch chan<- io.ReadCloser) {
+	chunkWriter := NewChunkWriter(ch, extensionChunkSize)
+	err := func() error {
+		bufWriter := bufio.NewWriterSize(chunkWriter, extensionBufferSize)
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			return sdkerrors.Wrap(err, "zlib failure")
+		}
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+		err = protoWriter.WriteMsg(&storetypes.SnapshotItem{
+			Item: &storetypes.SnapshotItem_Extension{
+				Extension: &storetypes.SnapshotExtensionMeta{
+					Name:   extension.SnapshotName(),
+					Format: extension.SnapshotFormat(),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		err = extension.SnapshotExtension(height, func(payload []byte) error {
+			return protoWriter.WriteMsg(&storetypes.SnapshotItem{
+				Item: &storetypes.SnapshotItem_ExtensionPayload{
+					ExtensionPayload: &storetypes.SnapshotExtensionPayload{
+						Payload: payload,
+					},
+				},
+			})
+		})
+		if err != nil {
+			return sdkerrors.Wrapf(err, "extension %q snapshot failed", extension.SnapshotName())
+		}
+		// Closing the delimited writer also closes and flushes the zlib writer.
+		if err := protoWriter.Close(); err != nil {
+			return err
+		}
+		return bufWriter.Flush()
+	}()
+	if err != nil {
+		chunkWriter.CloseWithError(err)
+		return
+	}
+	chunkWriter.Close()
+}
+
+// validateExtensions checks that the extension payload sections of a snapshot can be restored,
+// i.e. that the extensions are registered and support the section formats.
+func (m *Manager) validateExtensions(snapshot types.Snapshot) error {
+	chunks := uint32(0)
+	for _, metadata := range snapshot.Metadata.Extensions {
+		extension, ok := m.extensions[metadata.Name]
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "unknown extension snapshotter %q", metadata.Name)
+		}
+		if !types.IsFormatSupported(extension.SupportedFormats(), metadata.Format) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %q",
+				metadata.Format, metadata.Name)
+		}
+		if metadata.Chunks == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "extension %q has no chunks", metadata.Name)
+		}
+		chunks += metadata.Chunks
+		if chunks > snapshot.Chunks {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "extensions have more than %v chunks",
+				snapshot.Chunks)
+		}
+	}
+	return nil
+}
+
+// restoreSnapshot restores the multistore from the leading snapshot chunks, followed by each
+// extension payload section in turn. If appHash is given and the target is a TrustedSnapshotter,
+// the restored multistore is verified against it. On errors, it returns without waiting for the
+// remaining chunks, which are discarded once the manager ends the restore operation.
+func (m *Manager) restoreSnapshot(
+	snapshot types.Snapshot, appHash []byte, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	storeChunks := snapshot.Chunks
+	for _, metadata := range snapshot.Metadata.Extensions {
+		storeChunks -= metadata.Chunks
+	}
+	sectionChunks := forwardChunks(chunks, storeChunks)
+	var err error
+	if target, ok := m.target.(types.TrustedSnapshotter); ok && appHash != nil {
+		err = target.RestoreTrusted(snapshot.Height, snapshot.Format, appHash, sectionChunks, ready)
+	} else {
+		err = m.target.Restore(snapshot.Height, snapshot.Format, sectionChunks, ready)
+	}
+	if err != nil {
+		go DrainChunks(sectionChunks)
+		return err
+	}
+	DrainChunks(sectionChunks)
+
+	for _, metadata := range snapshot.Metadata.Extensions {
+		sectionChunks := forwardChunks(chunks, metadata.Chunks)
+		err := m.restoreExtension(snapshot.Height, metadata, sectionChunks)
+		if err != nil {
+			go DrainChunks(sectionChunks)
+			return err
+		}
+		DrainChunks(sectionChunks)
+	}
+	return nil
+}
+
+// restoreExtension restores an extension from its payload section.
+func (m *Manager) restoreExtension(
+	height uint64, metadata types.ExtensionMetadata, chunks <-chan io.ReadCloser,
+) error {
+	chunkReader := NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, extensionMaxItemSize)
+	defer protoReader.Close()
+
+	item := &storetypes.SnapshotItem{}
+	if err := protoReader.ReadMsg(item); err != nil {
+		return sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+	meta, ok := item.Item.(*storetypes.SnapshotItem_Extension)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected extension item, got %T", item.Item)
+	}
+	if meta.Extension.Name != metadata.Name || meta.Extension.Format != metadata.Format {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected extension %q format %v, got %q format %v",
+			metadata.Name, metadata.Format, meta.Extension.Name, meta.Extension.Format)
+	}
+
+	payloadReader := func() ([]byte, error) {
+		item := &storetypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			return nil, io.EOF
+		} else if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		payload, ok := item.Item.(*storetypes.SnapshotItem_ExtensionPayload)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected extension payload item, got %T", item.Item)
+		}
+		return payload.ExtensionPayload.Payload, nil
+	}
+	err = m.extensions[metadata.Name].RestoreExtension(height, metadata.Format, payloadReader)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "extension %q restore failed", metadata.Name)
+	}
+	return nil
+}
+
+// forwardChunks forwards the next n chunks of a chunk channel to a new channel, which is closed
+// afterwards.
+func forwardChunks(chunks <-chan io.ReadCloser, n uint32) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, chunkBufferSize)
+	go func() {
+		defer close(ch)
+		for i := uint32(0); i < n; i++ {
+			chunk, ok := <-chunks
+			if !ok {
+				return
+			}
+			ch <- chunk
+		}
+	}()
+	return ch
+}
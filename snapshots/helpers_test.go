@@ -97,6 +97,55 @@ func (m *mockSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.Read
 	return ch, nil
 }
 
+func (m *mockSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{types.FormatV1, types.FormatV2}
+}
+
+// mockExtension is an extension snapshotter which snapshots and restores a list of payloads.
+type mockExtension struct {
+	name     string
+	payloads [][]byte
+}
+
+func (m *mockExtension) SnapshotName() string {
+	return m.name
+}
+
+func (m *mockExtension) SnapshotFormat() uint32 {
+	return 2
+}
+
+func (m *mockExtension) SupportedFormats() []uint32 {
+	return []uint32{1, 2}
+}
+
+func (m *mockExtension) SnapshotExtension(height uint64, payloadWriter types.ExtensionPayloadWriter) error {
+	for _, payload := range m.payloads {
+		if err := payloadWriter(payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockExtension) RestoreExtension(
+	height uint64, format uint32, payloadReader types.ExtensionPayloadReader,
+) error {
+	if m.payloads != nil {
+		return errors.New("already has contents")
+	}
+	m.payloads = [][]byte{}
+	for {
+		payload, err := payloadReader()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		m.payloads = append(m.payloads, payload)
+	}
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
@@ -144,6 +193,10 @@ func (m *hungSnapshotter) Snapshot(height uint64, format uint32) (<-chan io.Read
 	return ch, nil
 }
 
+func (m *hungSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{types.CurrentFormat}
+}
+
 func (m *hungSnapshotter) Restore(
 	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
 ) error {
@@ -42,8 +42,10 @@ type restoreDone struct {
 // 2) io.ReadCloser streams automatically propagate IO errors, and can pass arbitrary
 //    errors via io.Pipe.CloseWithError().
 type Manager struct {
-	store  *Store
-	target types.Snapshotter
+	store      *Store
+	target     types.Snapshotter
+	format     uint32
+	extensions map[string]types.ExtensionSnapshotter
 
 	mtx                sync.Mutex
 	operation          operation
@@ -53,12 +55,42 @@ type Manager struct {
 	restoreChunkIndex  uint32
 }
 
-// NewManager creates a new manager.
+// NewManager creates a new manager. Snapshots are created in types.CurrentFormat, or in the first
+// format supported by the target if it does not support the current format.
 func NewManager(store *Store, target types.Snapshotter) *Manager {
+	format := types.CurrentFormat
+	if target != nil {
+		formats := target.SupportedFormats()
+		if len(formats) > 0 && !types.IsFormatSupported(formats, format) {
+			format = formats[0]
+		}
+	}
 	return &Manager{
-		store:  store,
-		target: target,
+		store:      store,
+		target:     target,
+		format:     format,
+		extensions: map[string]types.ExtensionSnapshotter{},
+	}
+}
+
+// SetFormat sets the format used when creating new snapshots. It defaults to types.CurrentFormat,
+// but older formats can be used to serve nodes that do not yet support it. Snapshots offered for
+// restoration are accepted in any format supported by the target.
+func (m *Manager) SetFormat(format uint32) error {
+	if !types.IsFormatSupported(m.target.SupportedFormats(), format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
 	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.format = format
+	return nil
+}
+
+// Format returns the format used when creating new snapshots.
+func (m *Manager) Format() uint32 {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.format
 }
 
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
@@ -104,7 +136,11 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	if m == nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
 	}
-	err := m.begin(opSnapshot)
+	m.mtx.Lock()
+	format := m.format
+	extensions := m.sortedExtensions()
+	err := m.beginLocked(opSnapshot)
+	m.mtx.Unlock()
 	if err != nil {
 		return nil, err
 	}
@@ -119,11 +155,16 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	chunks, err := m.target.Snapshot(height, types.CurrentFormat)
+	chunks, err := m.target.Snapshot(height, format)
 	if err != nil {
 		return nil, err
 	}
-	return m.store.Save(height, types.CurrentFormat, chunks)
+	var metadata []types.ExtensionMetadata
+	chunks = appendExtensions(height, chunks, extensions, &metadata)
+	return m.store.save(height, format, chunks, func(snapshot *types.Snapshot) error {
+		snapshot.Metadata.Extensions = metadata
+		return nil
+	})
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -157,8 +198,14 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 }
 
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
-// via RestoreChunk() until the restore is complete or a chunk fails.
-func (m *Manager) Restore(snapshot types.Snapshot) error {
+// via RestoreChunk() until the restore is complete or a chunk fails. Snapshots in a format not
+// supported by the target are rejected with ErrUnknownFormat, such that Tendermint can offer a
+// snapshot in a different format instead. appHash is the trusted app hash at the snapshot height,
+// which targets implementing types.TrustedSnapshotter verify the restored state against.
+func (m *Manager) Restore(snapshot types.Snapshot, appHash []byte) error {
+	if !types.IsFormatSupported(m.target.SupportedFormats(), snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", snapshot.Format)
+	}
 	if snapshot.Chunks == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
 	}
@@ -169,6 +216,9 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	}
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
+	if err := m.validateExtensions(snapshot); err != nil {
+		return err
+	}
 	err := m.beginLocked(opRestore)
 	if err != nil {
 		return err
@@ -179,7 +229,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		err := m.restoreSnapshot(snapshot, appHash, chChunks, chReady)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -205,6 +255,37 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// RestoreLocalSnapshot restores the app state from a snapshot in the local snapshot store, such
+// as one loaded from an archive, blocking until the restore is complete.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+	if !types.IsFormatSupported(m.target.SupportedFormats(), snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", snapshot.Format)
+	}
+
+	m.mtx.Lock()
+	err = m.validateExtensions(*snapshot)
+	if err == nil {
+		err = m.beginLocked(opRestore)
+	}
+	m.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+	defer m.end()
+	return m.restoreSnapshot(*snapshot, nil, chunks, nil)
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -95,6 +95,88 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_SetFormat(t *testing.T) {
+	store := setupStore(t)
+	snapshotter := &mockSnapshotter{
+		chunks: [][]byte{{1, 2, 3}},
+	}
+	manager := snapshots.NewManager(store, snapshotter)
+	require.Equal(t, types.CurrentFormat, manager.Format())
+
+	err := manager.SetFormat(9)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+	require.Equal(t, types.CurrentFormat, manager.Format())
+
+	// snapshots should be created in the configured format
+	require.NoError(t, manager.SetFormat(types.FormatV1))
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.Equal(t, types.FormatV1, snapshot.Format)
+
+	_, chunks, err := store.Load(snapshot.Height, types.FormatV1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{1, 2, 3}}, readChunks(chunks))
+}
+
+func TestManager_Extensions(t *testing.T) {
+	store := setupStore(t)
+	snapshotter := &mockSnapshotter{
+		chunks: [][]byte{{1, 2, 3}, {4, 5, 6}},
+	}
+	extension1 := &mockExtension{name: "ext1", payloads: [][]byte{{1}, {2, 2}}}
+	extension2 := &mockExtension{name: "ext2", payloads: [][]byte{{3, 3, 3}}}
+	manager := snapshots.NewManager(store, snapshotter)
+	require.NoError(t, manager.RegisterExtensions(extension2, extension1))
+
+	err := manager.RegisterExtensions(&mockExtension{name: "ext1"})
+	require.Error(t, err)
+	err = manager.RegisterExtensions(&mockExtension{})
+	require.Error(t, err)
+
+	// extension payload sections are appended to the multistore chunks in name order
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, snapshot.Chunks)
+	assert.Equal(t, []types.ExtensionMetadata{
+		{Name: "ext1", Format: 2, Chunks: 1},
+		{Name: "ext2", Format: 2, Chunks: 1},
+	}, snapshot.Metadata.Extensions)
+
+	storeSnapshot, err := store.Get(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, storeSnapshot)
+
+	_, chunkReaders, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	chunks := readChunks(chunkReaders)
+
+	// restoring should pass the multistore chunks to the target, and the payloads to extensions
+	target := &mockSnapshotter{}
+	targetExtension1 := &mockExtension{name: "ext1"}
+	targetExtension2 := &mockExtension{name: "ext2"}
+	manager = snapshots.NewManager(setupStore(t), target)
+	require.NoError(t, manager.RegisterExtensions(targetExtension1))
+
+	// an unregistered extension makes the snapshot format unsupported
+	err = manager.Restore(*snapshot, nil)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	require.NoError(t, manager.RegisterExtensions(targetExtension2))
+	err = manager.Restore(*snapshot, nil)
+	require.NoError(t, err)
+	for i, chunk := range chunks {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(chunks)-1, done)
+	}
+
+	assert.Equal(t, snapshotter.chunks, target.chunks)
+	assert.Equal(t, extension1.payloads, targetExtension1.payloads)
+	assert.Equal(t, extension2.payloads, targetExtension2.payloads)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store := setupStore(t)
 	manager := snapshots.NewManager(store, nil)
@@ -131,12 +213,23 @@ func TestManager_Restore(t *testing.T) {
 		Hash:     []byte{1, 2, 3},
 		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
-	})
+	}, nil)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	// Restore errors on formats not supported by the target, before starting the restore
+	err = manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   9,
+		Hash:     []byte{1, 2, 3},
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	}, nil)
 	require.Error(t, err)
-	require.Equal(t, types.ErrUnknownFormat, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
 
 	// Restore errors on no chunks
-	err = manager.Restore(types.Snapshot{Height: 3, Format: 1, Hash: []byte{1, 2, 3}})
+	err = manager.Restore(types.Snapshot{Height: 3, Format: 1, Hash: []byte{1, 2, 3}}, nil)
 	require.Error(t, err)
 
 	// Restore errors on chunk and chunkhashes mismatch
@@ -146,7 +239,7 @@ func TestManager_Restore(t *testing.T) {
 		Hash:     []byte{1, 2, 3},
 		Chunks:   4,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
-	})
+	}, nil)
 	require.Error(t, err)
 
 	// Starting a restore works
@@ -156,7 +249,7 @@ func TestManager_Restore(t *testing.T) {
 		Hash:     []byte{1, 2, 3},
 		Chunks:   3,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
-	})
+	}, nil)
 	require.NoError(t, err)
 
 	// While the restore is in progress, any other operations fail
@@ -191,7 +284,7 @@ func TestManager_Restore(t *testing.T) {
 		Hash:     []byte{1, 2, 3},
 		Chunks:   3,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
-	})
+	}, nil)
 	require.Error(t, err)
 
 	// But if we clear out the target we should be able to start a new restore. This time we'll
@@ -204,6 +297,35 @@ func TestManager_Restore(t *testing.T) {
 		Hash:     []byte{1, 2, 3},
 		Chunks:   3,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
-	})
+	}, nil)
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	// Restoring a missing snapshot errors
+	err := manager.RestoreLocalSnapshot(9, 1)
+	require.Error(t, err)
+
+	// Restoring a snapshot in a format not supported by the target errors
+	_, err = store.Save(4, 9, makeChunks([][]byte{{4, 9, 0}}))
+	require.NoError(t, err)
+	err = manager.RestoreLocalSnapshot(4, 9)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	// Restoring a local snapshot works
+	err = manager.RestoreLocalSnapshot(2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, target.chunks)
+
+	// The restore has ended, so other operations can proceed, but restoring again fails
+	// because the target already has contents.
+	_, err = manager.Prune(10)
+	require.NoError(t, err)
+	err = manager.RestoreLocalSnapshot(3, 2)
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"io"
@@ -13,6 +14,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	db "github.com/tendermint/tm-db"
 
+	util "github.com/cosmos/cosmos-sdk/internal"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -222,6 +224,44 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, chunks, nil)
+}
+
+// Import saves a snapshot created elsewhere to disk, e.g. one loaded from an archive, returning
+// it. The chunks must match the chunk hashes of the given snapshot, whose extension metadata is
+// retained.
+func (s *Store) Import(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
+	if len(snapshot.Metadata.ChunkHashes) != int(snapshot.Chunks) {
+		DrainChunks(chunks)
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, expected %v",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+	return s.save(snapshot.Height, snapshot.Format, chunks, func(saved *types.Snapshot) error {
+		if saved.Chunks != snapshot.Chunks {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "expected %v chunks, got %v",
+				snapshot.Chunks, saved.Chunks)
+		}
+		for i, hash := range saved.Metadata.ChunkHashes {
+			if !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+				return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x",
+					i, snapshot.Metadata.ChunkHashes[i], hash)
+			}
+		}
+		if !bytes.Equal(saved.Hash, snapshot.Hash) {
+			return sdkerrors.Wrapf(types.ErrChunkHashMismatch, "snapshot: expected %x, got %x",
+				snapshot.Hash, saved.Hash)
+		}
+		saved.Metadata.Extensions = snapshot.Metadata.Extensions
+		return nil
+	})
+}
+
+// save saves a snapshot to disk, returning it. If finalize is non-nil, it is called once all
+// chunks have been saved, and may fill in further metadata or reject the snapshot before it is
+// stored. Chunks of rejected snapshots are removed.
+func (s *Store) save(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, finalize func(*types.Snapshot) error,
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -290,6 +330,12 @@ func (s *Store) Save(
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
+	if finalize != nil {
+		if err = finalize(snapshot); err != nil {
+			return nil, util.CombineErrors(err, os.RemoveAll(s.pathSnapshot(height, format)),
+				"failed to remove snapshot chunks")
+		}
+	}
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
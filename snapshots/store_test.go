@@ -332,3 +332,45 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_Import(t *testing.T) {
+	store := setupStore(t)
+	chunks := [][]byte{{1}, {2}}
+	snapshot := &types.Snapshot{
+		Height: 4,
+		Format: 1,
+		Chunks: 2,
+		Hash:   hash(chunks),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums(chunks),
+			Extensions:  []types.ExtensionMetadata{{Name: "ext", Format: 1, Chunks: 1}},
+		},
+	}
+
+	// Importing chunks that don't match the snapshot should error, and leave no snapshot behind
+	_, err := store.Import(snapshot, makeChunks([][]byte{{1}, {3}}))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+	_, err = store.Import(snapshot, makeChunks([][]byte{{1}}))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidMetadata))
+	missingHashes := *snapshot
+	missingHashes.Metadata.ChunkHashes = checksums(chunks[:1])
+	_, err = store.Import(&missingHashes, makeChunks(chunks))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidMetadata))
+	loaded, err := store.Get(4, 1)
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+	chunk, err := store.LoadChunk(4, 1, 0)
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+
+	// Importing matching chunks should work, retaining the extension metadata
+	imported, err := store.Import(snapshot, makeChunks(chunks))
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, imported)
+	loaded, err = store.Get(4, 1)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+}
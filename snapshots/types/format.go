@@ -1,6 +1,32 @@
 package types
 
+const (
+	// FormatV1 is the original snapshot format: a single zlib-compressed stream of snapshot items
+	// for all stores in order, split into fixed-size chunks. Chunks may span store boundaries,
+	// so the snapshot must be generated and restored serially.
+	FormatV1 uint32 = 1
+
+	// FormatV2 writes each store as an independent stream of self-contained chunks, with the
+	// chunks of all stores interleaved in a deterministic order. Stores can thus be exported and
+	// imported concurrently.
+	FormatV2 uint32 = 2
+
+	// FormatKV is the snapshot format of store/v2 multistores: a single zlib-compressed stream
+	// of the key/value pairs of each substore, from which the state commitments are rebuilt.
+	FormatKV uint32 = 3
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+const CurrentFormat uint32 = FormatV2
+
+// IsFormatSupported returns true if the given format is in the list of supported formats.
+func IsFormatSupported(formats []uint32, format uint32) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -13,4 +13,48 @@ type Snapshotter interface {
 	// If the ready channel is non-nil, it returns a ready signal (by being closed) once the
 	// restorer is ready to accept chunks.
 	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
+
+	// SupportedFormats returns the snapshot formats the snapshotter can create and restore.
+	SupportedFormats() []uint32
+}
+
+// TrustedSnapshotter is a Snapshotter which can verify the restored state against an app hash
+// trusted by the caller, such as the one Tendermint verified with its light client, instead of
+// relying on the hashes contained in the snapshot itself.
+type TrustedSnapshotter interface {
+	Snapshotter
+
+	// RestoreTrusted restores a state snapshot like Restore, and returns an error without
+	// committing the restored state if it does not hash to appHash.
+	RestoreTrusted(height uint64, format uint32, appHash []byte, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
+}
+
+// ExtensionPayloadReader reads the next extension payload. It returns io.EOF once the end of the
+// extension's payload section has been reached.
+type ExtensionPayloadReader = func() ([]byte, error)
+
+// ExtensionPayloadWriter writes an extension payload to the snapshot stream.
+type ExtensionPayloadWriter = func([]byte) error
+
+// ExtensionSnapshotter is a snapshotter for state kept outside of the multistore, e.g. module
+// data stored on disk. Extensions are registered with the snapshot manager, and their payloads
+// are appended to the multistore snapshot as separate sections identified by the extension
+// name. Like the multistore snapshot, the payloads for a given format must be identical across
+// nodes such that chunks from different sources fit together.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the extension, which must be unique across extensions.
+	SnapshotName() string
+
+	// SnapshotFormat returns the format used when creating extension snapshots.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns the extension snapshot formats that can be restored.
+	SupportedFormats() []uint32
+
+	// SnapshotExtension writes the extension state at the given height as a sequence of payloads.
+	SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// RestoreExtension restores the extension state from a sequence of payloads, read until
+	// io.EOF, which were written in the given format.
+	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
 }
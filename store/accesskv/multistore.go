@@ -0,0 +1,165 @@
+package accesskv
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.CacheMultiStore = &MultiStore{}
+
+// MultiStore branches a parent MultiStore, recording the accesses made to each of its substores.
+// Only accesses which reach the parent are recorded: reads of keys already written to the branch
+// are served by the branch itself, and writes are recorded when the branch is written to the parent.
+//
+// Substores are branched lazily when first accessed, so that the parent's substores need not be
+// known in advance. Tracing and listening are not supported.
+type MultiStore struct {
+	*cacheMultiStore
+	accesses map[types.StoreKey]*AccessSet
+}
+
+// NewMultiStore returns a new MultiStore branching a parent MultiStore.
+func NewMultiStore(parent types.MultiStore) *MultiStore {
+	ms := &MultiStore{accesses: map[types.StoreKey]*AccessSet{}}
+	ms.cacheMultiStore = newCacheMultiStore(parent, func(key types.StoreKey, store types.KVStore) types.KVStore {
+		accesses := NewAccessSet()
+		ms.accesses[key] = accesses
+		return NewStore(store, accesses)
+	})
+	return ms
+}
+
+// Accesses returns the accesses recorded for each substore that has been accessed.
+func (ms *MultiStore) Accesses() map[types.StoreKey]*AccessSet {
+	return ms.accesses
+}
+
+// StoreAccesses returns the accesses recorded for each substore that has been accessed, ordered by
+// store key name.
+func (ms *MultiStore) StoreAccesses() []*types.StoreAccesses {
+	ret := make([]*types.StoreAccesses, 0, len(ms.accesses))
+	for key, accesses := range ms.accesses {
+		ret = append(ret, accesses.StoreAccesses(key.Name()))
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].StoreKey < ret[j].StoreKey })
+	return ret
+}
+
+// ReadsWrittenBy returns whether any substore's reads were written in the same substore of another
+// set of accesses.
+func (ms *MultiStore) ReadsWrittenBy(written map[types.StoreKey]*AccessSet) bool {
+	for key, accesses := range ms.accesses {
+		if other, has := written[key]; has && accesses.ReadsWrittenBy(other) {
+			return true
+		}
+	}
+	return false
+}
+
+// A MultiStore branch whose substores are branched on first access.
+type cacheMultiStore struct {
+	parent types.MultiStore
+	stores map[types.StoreKey]types.CacheKVStore
+	// Optionally wraps the parent's substores before they are branched
+	wrap func(types.StoreKey, types.KVStore) types.KVStore
+}
+
+func newCacheMultiStore(parent types.MultiStore, wrap func(types.StoreKey, types.KVStore) types.KVStore) *cacheMultiStore {
+	return &cacheMultiStore{
+		parent: parent,
+		stores: map[types.StoreKey]types.CacheKVStore{},
+		wrap:   wrap,
+	}
+}
+
+// GetStoreType implements the Store interface.
+func (cms *cacheMultiStore) GetStoreType() types.StoreType {
+	return types.StoreTypeMulti
+}
+
+// Write implements the CacheMultiStore interface. Substores are written in order of their names.
+func (cms *cacheMultiStore) Write() {
+	keys := make([]types.StoreKey, 0, len(cms.stores))
+	for key := range cms.stores {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+	for _, key := range keys {
+		cms.stores[key].Write()
+	}
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (cms *cacheMultiStore) CacheWrap() types.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface. Tracing is not supported.
+func (cms *cacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return cms.CacheWrap()
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface. Listening is not supported.
+func (cms *cacheMultiStore) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	return cms.CacheWrap()
+}
+
+// CacheMultiStore implements the MultiStore interface.
+func (cms *cacheMultiStore) CacheMultiStore() types.CacheMultiStore {
+	return newCacheMultiStore(cms, nil)
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface. It will panic as an already
+// branched multi-store cannot load previous versions.
+func (cms *cacheMultiStore) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, error) {
+	panic("cannot branch cached multi-store with a version")
+}
+
+// GetStore implements the MultiStore interface.
+func (cms *cacheMultiStore) GetStore(key types.StoreKey) types.Store {
+	return cms.GetKVStore(key)
+}
+
+// GetKVStore implements the MultiStore interface.
+func (cms *cacheMultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	if key == nil {
+		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
+	}
+	if store, has := cms.stores[key]; has {
+		return store
+	}
+	parent := cms.parent.GetKVStore(key)
+	if cms.wrap != nil {
+		parent = cms.wrap(key, parent)
+	}
+	store := cachekv.NewStore(parent)
+	cms.stores[key] = store
+	return store
+}
+
+// TracingEnabled implements the MultiStore interface.
+func (cms *cacheMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements the MultiStore interface. Tracing is not supported.
+func (cms *cacheMultiStore) SetTracer(_ io.Writer) types.MultiStore {
+	return cms
+}
+
+// SetTracingContext implements the MultiStore interface. Tracing is not supported.
+func (cms *cacheMultiStore) SetTracingContext(_ types.TraceContext) types.MultiStore {
+	return cms
+}
+
+// ListeningEnabled implements the MultiStore interface.
+func (cms *cacheMultiStore) ListeningEnabled(_ types.StoreKey) bool {
+	return false
+}
+
+// AddListeners implements the MultiStore interface. Listening is not supported.
+func (cms *cacheMultiStore) AddListeners(_ types.StoreKey, _ []types.WriteListener) {}
@@ -0,0 +1,207 @@
+package accesskv
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// Range is a domain of keys iterated over, from Start (inclusive) to End (exclusive). A nil Start
+// or End means the domain is unbounded in that direction.
+type Range struct {
+	Start, End []byte
+}
+
+// Contains returns whether a key is within the range.
+func (r Range) Contains(key []byte) bool {
+	return (r.Start == nil || bytes.Compare(key, r.Start) >= 0) &&
+		(r.End == nil || bytes.Compare(key, r.End) < 0)
+}
+
+// AccessSet records the keys accessed in a KVStore. Keys are recorded as strings so that they can
+// be used as map keys.
+type AccessSet struct {
+	// Keys read by Get or Has
+	Reads map[string]struct{}
+	// Keys last written by Set
+	Writes map[string]struct{}
+	// Keys last written by Delete
+	Deletes map[string]struct{}
+	// Domains of the iterators created
+	Ranges []Range
+}
+
+// NewAccessSet returns an empty AccessSet.
+func NewAccessSet() *AccessSet {
+	return &AccessSet{
+		Reads:   map[string]struct{}{},
+		Writes:  map[string]struct{}{},
+		Deletes: map[string]struct{}{},
+	}
+}
+
+// IsWritten returns whether a key was set or deleted.
+func (as *AccessSet) IsWritten(key string) bool {
+	_, set := as.Writes[key]
+	_, deleted := as.Deletes[key]
+	return set || deleted
+}
+
+// ReadsWrittenBy returns whether any key read, or any key within a range iterated over, was set or
+// deleted in another AccessSet of the same store.
+func (as *AccessSet) ReadsWrittenBy(other *AccessSet) bool {
+	for key := range as.Reads {
+		if other.IsWritten(key) {
+			return true
+		}
+	}
+	if len(as.Ranges) == 0 {
+		return false
+	}
+	for _, written := range []map[string]struct{}{other.Writes, other.Deletes} {
+		for key := range written {
+			for _, r := range as.Ranges {
+				if r.Contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// MergeWrites adds the writes of another AccessSet to this one, as if they were made after its own.
+func (as *AccessSet) MergeWrites(other *AccessSet) {
+	for key := range other.Writes {
+		as.Writes[key] = struct{}{}
+		delete(as.Deletes, key)
+	}
+	for key := range other.Deletes {
+		as.Deletes[key] = struct{}{}
+		delete(as.Writes, key)
+	}
+}
+
+// StoreAccesses returns the accesses of a store with the given store key name, with keys sorted.
+func (as *AccessSet) StoreAccesses(storeKey string) *types.StoreAccesses {
+	ret := &types.StoreAccesses{
+		StoreKey: storeKey,
+		Reads:    sortedKeys(as.Reads),
+		Writes:   sortedKeys(as.Writes),
+		Deletes:  sortedKeys(as.Deletes),
+	}
+	for _, r := range as.Ranges {
+		ret.Ranges = append(ret.Ranges, &types.KeyRange{Start: r.Start, End: r.End})
+	}
+	return ret
+}
+
+func sortedKeys(keys map[string]struct{}) [][]byte {
+	if len(keys) == 0 {
+		return nil
+	}
+	strs := make([]string, 0, len(keys))
+	for key := range keys {
+		strs = append(strs, key)
+	}
+	sort.Strings(strs)
+	ret := make([][]byte, len(strs))
+	for i, key := range strs {
+		ret[i] = []byte(key)
+	}
+	return ret
+}
+
+// Store implements the KVStore interface, recording the keys accessed in the parent KVStore to an
+// AccessSet.
+type Store struct {
+	parent   types.KVStore
+	accesses *AccessSet
+}
+
+// NewStore returns a reference to a new Store given a parent KVStore and the AccessSet to record to.
+func NewStore(parent types.KVStore, accesses *AccessSet) *Store {
+	return &Store{parent: parent, accesses: accesses}
+}
+
+// Get implements the KVStore interface. It records a read and delegates the Get call to the parent
+// KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.accesses.Reads[string(key)] = struct{}{}
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface. It records a read and delegates the Has call to the parent
+// KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.accesses.Reads[string(key)] = struct{}{}
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface. It records a write and delegates the Set call to the parent
+// KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	types.AssertValidKey(key)
+	s.parent.Set(key, value)
+	s.accesses.Writes[string(key)] = struct{}{}
+	delete(s.accesses.Deletes, string(key))
+}
+
+// Delete implements the KVStore interface. It records a delete and delegates the Delete call to the
+// parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.parent.Delete(key)
+	s.accesses.Deletes[string(key)] = struct{}{}
+	delete(s.accesses.Writes, string(key))
+}
+
+// Iterator implements the KVStore interface. It records the iterated range and delegates the
+// Iterator call to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.recordRange(start, end)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It records the iterated range and delegates the
+// ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.recordRange(start, end)
+	return s.parent.ReverseIterator(start, end)
+}
+
+func (s *Store) recordRange(start, end []byte) {
+	r := Range{}
+	if start != nil {
+		r.Start = append([]byte{}, start...)
+	}
+	if end != nil {
+		r.End = append([]byte{}, end...)
+	}
+	s.accesses.Ranges = append(s.accesses.Ranges, r)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. It panics because a Store cannot be branched.
+func (s *Store) CacheWrap() types.CacheWrap {
+	panic("cannot CacheWrap an AccessKVStore")
+}
+
+// CacheWrapWithTrace implements the KVStore interface. It panics because a Store cannot be
+// branched.
+func (s *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	panic("cannot CacheWrapWithTrace an AccessKVStore")
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface. It panics because a Store cannot be
+// branched.
+func (s *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListener) types.CacheWrap {
+	panic("cannot CacheWrapWithListeners an AccessKVStore")
+}
@@ -0,0 +1,133 @@
+package accesskv_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+func newAccessKVStore() (*accesskv.Store, *accesskv.AccessSet) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < 3; i++ {
+		parent.Set(keyFmt(i), valFmt(i))
+	}
+	accesses := accesskv.NewAccessSet()
+	return accesskv.NewStore(parent, accesses), accesses
+}
+
+func TestAccessKVStoreRecordsAccesses(t *testing.T) {
+	store, accesses := newAccessKVStore()
+
+	require.Equal(t, valFmt(0), store.Get(keyFmt(0)))
+	require.False(t, store.Has(keyFmt(5)))
+	store.Set(keyFmt(1), valFmt(10))
+	store.Delete(keyFmt(2))
+	store.Set(keyFmt(3), valFmt(3))
+	store.Delete(keyFmt(3))
+	store.Iterator(keyFmt(10), keyFmt(20)).Close()
+	store.ReverseIterator(nil, keyFmt(30)).Close()
+
+	require.Equal(t, map[string]struct{}{string(keyFmt(0)): {}, string(keyFmt(5)): {}}, accesses.Reads)
+	require.Equal(t, map[string]struct{}{string(keyFmt(1)): {}}, accesses.Writes)
+	require.Equal(t, map[string]struct{}{string(keyFmt(2)): {}, string(keyFmt(3)): {}}, accesses.Deletes)
+	require.Equal(t, []accesskv.Range{{Start: keyFmt(10), End: keyFmt(20)}, {End: keyFmt(30)}}, accesses.Ranges)
+	require.True(t, accesses.IsWritten(string(keyFmt(1))))
+	require.True(t, accesses.IsWritten(string(keyFmt(3))))
+	require.False(t, accesses.IsWritten(string(keyFmt(0))))
+
+	require.Panics(t, func() { store.CacheWrap() })
+	require.Panics(t, func() { store.CacheWrapWithTrace(nil, nil) })
+	require.Panics(t, func() { store.CacheWrapWithListeners(nil, nil) })
+}
+
+func TestAccessSetReadsWrittenBy(t *testing.T) {
+	reads := accesskv.NewAccessSet()
+	reads.Reads[string(keyFmt(1))] = struct{}{}
+	reads.Ranges = append(reads.Ranges, accesskv.Range{Start: keyFmt(10), End: keyFmt(20)})
+
+	testCases := []struct {
+		name     string
+		writes   func(*accesskv.AccessSet)
+		expected bool
+	}{
+		{"no writes", func(*accesskv.AccessSet) {}, false},
+		{"unrelated write", func(as *accesskv.AccessSet) { as.Writes[string(keyFmt(2))] = struct{}{} }, false},
+		{"read key set", func(as *accesskv.AccessSet) { as.Writes[string(keyFmt(1))] = struct{}{} }, true},
+		{"read key deleted", func(as *accesskv.AccessSet) { as.Deletes[string(keyFmt(1))] = struct{}{} }, true},
+		{"key set in range", func(as *accesskv.AccessSet) { as.Writes[string(keyFmt(15))] = struct{}{} }, true},
+		{"key deleted in range", func(as *accesskv.AccessSet) { as.Deletes[string(keyFmt(10))] = struct{}{} }, true},
+		{"key set at range end", func(as *accesskv.AccessSet) { as.Writes[string(keyFmt(20))] = struct{}{} }, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			writes := accesskv.NewAccessSet()
+			tc.writes(writes)
+			require.Equal(t, tc.expected, reads.ReadsWrittenBy(writes))
+		})
+	}
+
+	// later writes replace earlier ones
+	written := accesskv.NewAccessSet()
+	written.Writes[string(keyFmt(1))] = struct{}{}
+	later := accesskv.NewAccessSet()
+	later.Deletes[string(keyFmt(1))] = struct{}{}
+	later.Writes[string(keyFmt(2))] = struct{}{}
+	written.MergeWrites(later)
+	require.Equal(t, map[string]struct{}{string(keyFmt(2)): {}}, written.Writes)
+	require.Equal(t, map[string]struct{}{string(keyFmt(1)): {}}, written.Deletes)
+}
+
+func TestMultiStore(t *testing.T) {
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+	parent := cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		key1: dbadapter.Store{DB: dbm.NewMemDB()},
+		key2: dbadapter.Store{DB: dbm.NewMemDB()},
+	}, nil, nil, nil, nil)
+	parent.GetKVStore(key1).Set(keyFmt(1), valFmt(1))
+
+	ms := accesskv.NewMultiStore(parent)
+	store := ms.GetKVStore(key1)
+	require.Equal(t, valFmt(1), store.Get(keyFmt(1)))
+	store.Set(keyFmt(2), valFmt(2))
+	// reads of keys written to the branch don't reach the parent
+	require.Equal(t, valFmt(2), store.Get(keyFmt(2)))
+
+	// nested branches write through the multi-store
+	branch := ms.CacheMultiStore()
+	branch.GetKVStore(key2).Set(keyFmt(3), valFmt(3))
+	branch.Write()
+
+	// nothing is written to the parent, or recorded as written, until the branch is written
+	require.Nil(t, parent.GetKVStore(key1).Get(keyFmt(2)))
+	require.Empty(t, ms.Accesses()[key1].Writes)
+	ms.Write()
+	require.Equal(t, valFmt(2), parent.GetKVStore(key1).Get(keyFmt(2)))
+	require.Equal(t, valFmt(3), parent.GetKVStore(key2).Get(keyFmt(3)))
+
+	accesses := ms.Accesses()
+	require.Equal(t, map[string]struct{}{string(keyFmt(1)): {}}, accesses[key1].Reads)
+	require.Equal(t, map[string]struct{}{string(keyFmt(2)): {}}, accesses[key1].Writes)
+	require.Equal(t, map[string]struct{}{string(keyFmt(3)): {}}, accesses[key2].Writes)
+
+	require.Equal(t, []*types.StoreAccesses{
+		{StoreKey: key1.Name(), Reads: [][]byte{keyFmt(1)}, Writes: [][]byte{keyFmt(2)}},
+		{StoreKey: key2.Name(), Writes: [][]byte{keyFmt(3)}},
+	}, ms.StoreAccesses())
+
+	written := accesskv.NewAccessSet()
+	written.Writes[string(keyFmt(1))] = struct{}{}
+	require.True(t, ms.ReadsWrittenBy(map[types.StoreKey]*accesskv.AccessSet{key1: written}))
+	require.False(t, ms.ReadsWrittenBy(map[types.StoreKey]*accesskv.AccessSet{key2: written}))
+}
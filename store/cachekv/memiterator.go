@@ -3,45 +3,122 @@ package cachekv
 import (
 	"bytes"
 
-	dbm "github.com/tendermint/tm-db"
+	"github.com/google/btree"
 
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
-// Iterates over iterKVCache items.
-// if key is nil, means it was deleted.
+// memIteratorChunkSize is the number of items a memIterator reads from the btree at a time.
+const memIteratorChunkSize = 64
+
+// Iterates over a snapshot of the sorted cache's items.
+// if value is nil, means it was deleted.
 // Implements Iterator.
 type memIterator struct {
-	types.Iterator
+	items      *btree.BTree
+	start, end []byte
+	ascending  bool
+
+	chunk []*item
+	pos   int
+	done  bool
 
 	lastKey []byte
 	deleted map[string]struct{}
 }
 
-func newMemIterator(start, end []byte, items *dbm.MemDB, deleted map[string]struct{}, ascending bool) *memIterator {
-	var iter types.Iterator
-	var err error
+var _ types.Iterator = (*memIterator)(nil)
+
+func newMemIterator(start, end []byte, items *btree.BTree, deleted map[string]struct{}, ascending bool) *memIterator {
+	mi := &memIterator{
+		items:     items,
+		start:     start,
+		end:       end,
+		ascending: ascending,
+
+		lastKey: nil,
+		deleted: deleted,
+	}
+	mi.readChunk(nil)
+
+	return mi
+}
+
+// readChunk reads the next chunk of items in the iterator's domain, which follow the
+// given key in the iteration order, or from the beginning of the domain if it is nil.
+func (mi *memIterator) readChunk(after []byte) {
+	mi.chunk = make([]*item, 0, memIteratorChunkSize)
+	mi.pos = 0
 
-	if ascending {
-		iter, err = items.Iterator(start, end)
-	} else {
-		iter, err = items.ReverseIterator(start, end)
+	collect := func(i btree.Item) bool {
+		item := i.(*item)
+		switch {
+		case after != nil && bytes.Equal(item.key, after):
+			return true
+		case mi.ascending && mi.end != nil && bytes.Compare(item.key, mi.end) >= 0:
+			return false
+		case !mi.ascending && mi.end != nil && bytes.Compare(item.key, mi.end) >= 0:
+			// Only the end key itself, which is excluded from the domain.
+			return true
+		case !mi.ascending && bytes.Compare(item.key, mi.start) < 0:
+			return false
+		}
+		mi.chunk = append(mi.chunk, item)
+		return len(mi.chunk) < memIteratorChunkSize
 	}
 
-	if err != nil {
-		panic(err)
+	switch {
+	case mi.ascending && after != nil:
+		mi.items.AscendGreaterOrEqual(&item{key: after}, collect)
+	case mi.ascending && mi.start != nil:
+		mi.items.AscendGreaterOrEqual(&item{key: mi.start}, collect)
+	case mi.ascending:
+		mi.items.Ascend(collect)
+	case after != nil:
+		mi.items.DescendLessOrEqual(&item{key: after}, collect)
+	case mi.end != nil:
+		mi.items.DescendLessOrEqual(&item{key: mi.end}, collect)
+	default:
+		mi.items.Descend(collect)
 	}
 
-	return &memIterator{
-		Iterator: iter,
+	mi.done = len(mi.chunk) < memIteratorChunkSize
+}
 
-		lastKey: nil,
-		deleted: deleted,
+// Domain implements Iterator.
+func (mi *memIterator) Domain() (start []byte, end []byte) {
+	return mi.start, mi.end
+}
+
+// Valid implements Iterator.
+func (mi *memIterator) Valid() bool {
+	return mi.pos < len(mi.chunk)
+}
+
+func (mi *memIterator) assertValid() {
+	if !mi.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+// Next implements Iterator.
+func (mi *memIterator) Next() {
+	mi.assertValid()
+
+	mi.pos++
+	if mi.pos == len(mi.chunk) && !mi.done {
+		mi.readChunk(mi.chunk[mi.pos-1].key)
 	}
 }
 
+// Key implements Iterator.
+func (mi *memIterator) Key() []byte {
+	mi.assertValid()
+	return mi.chunk[mi.pos].key
+}
+
 func (mi *memIterator) Value() []byte {
-	key := mi.Iterator.Key()
+	key := mi.Key()
 	// We need to handle the case where deleted is modified and includes our current key
 	// We handle this by maintaining a lastKey object in the iterator.
 	// If the current key is the same as the last key (and last key is not nil / the start)
@@ -52,5 +129,16 @@ func (mi *memIterator) Value() []byte {
 		return nil
 	}
 	mi.lastKey = key
-	return mi.Iterator.Value()
+	return mi.chunk[mi.pos].value
+}
+
+// Error implements Iterator.
+func (mi *memIterator) Error() error {
+	return nil
+}
+
+// Close implements Iterator.
+func (mi *memIterator) Close() error {
+	mi.chunk = nil
+	return nil
 }
@@ -127,6 +127,41 @@ func benchmarkIteratorOnParentWithManyDeletes(b *testing.B, numDeletes int) {
 	}
 }
 
+// Benchmark interleaving writes of random keys with creating an iterator over a
+// small domain and reading its first items, as modules iterating over a prefix
+// after each of many writes in the same block do.
+// The parent store has numParentKeys entries.
+func benchmarkInterleavedSetIterate(b *testing.B, numParentKeys int) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	// Use a singleton for value, to not waste time computing it
+	value := randSlice(32)
+	for _, k := range generateRandomKeys(32, numParentKeys) {
+		mem.Set(k, value)
+	}
+	kvstore := cachekv.NewStore(mem)
+
+	keys := generateRandomKeys(32, b.N)
+	domains := generateRandomKeys(32, b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i, k := range keys {
+		kvstore.Set(k, value)
+
+		start := domains[i]
+		end := append(append([]byte{}, start[:1]...), 0xff)
+		iter := kvstore.Iterator(start, end)
+		for j := 0; j < 10 && iter.Valid(); j++ {
+			// deadcode elimination stub
+			sink = iter.Value()
+			iter.Next()
+		}
+		iter.Close()
+	}
+}
+
 func BenchmarkBlankParentIteratorNextKeySize32(b *testing.B) {
 	benchmarkBlankParentIteratorNext(b, 32)
 }
@@ -142,3 +177,11 @@ func BenchmarkSetKeySize32(b *testing.B) {
 func BenchmarkIteratorOnParentWith1MDeletes(b *testing.B) {
 	benchmarkIteratorOnParentWithManyDeletes(b, 1_000_000)
 }
+
+func BenchmarkInterleavedSetIterateBlankParent(b *testing.B) {
+	benchmarkInterleavedSetIterate(b, 0)
+}
+
+func BenchmarkInterleavedSetIterateParentWith10KKeys(b *testing.B) {
+	benchmarkInterleavedSetIterate(b, 10_000)
+}
@@ -306,6 +306,51 @@ func TestCacheKVMergeIteratorRandom(t *testing.T) {
 	}
 }
 
+func TestCacheKVMergeIteratorRandomBounds(t *testing.T) {
+	st := newCacheKVStore()
+	truth := dbm.NewMemDB()
+
+	max := 1000
+	setRange(t, st, truth, 0, max/2)
+	st.Write()
+
+	// do an op, test iterators over a random domain in both directions
+	for i := 0; i < 500; i++ {
+		doRandomOp(t, st, truth, max)
+
+		start := randInt(max)
+		end := randInt(max-start) + start
+		itr := st.Iterator(keyFmt(start), keyFmt(end))
+		itr2, err := truth.Iterator(keyFmt(start), keyFmt(end))
+		require.NoError(t, err)
+		checkIterators(t, itr, itr2)
+
+		itr = st.ReverseIterator(keyFmt(start), keyFmt(end))
+		itr2, err = truth.ReverseIterator(keyFmt(start), keyFmt(end))
+		require.NoError(t, err)
+		checkIterators(t, itr, itr2)
+	}
+}
+
+func TestCacheKVIteratorIgnoresLaterSets(t *testing.T) {
+	st := newCacheKVStore()
+	truth := dbm.NewMemDB()
+
+	setRange(t, st, truth, 0, 100)
+	itr := st.Iterator(nil, nil)
+	itr2, err := truth.Iterator(nil, nil)
+	require.NoError(t, err)
+
+	// sets after the iterator was created are not iterated over
+	for i := 0; i < 100; i++ {
+		st.Set(append(keyFmt(i), 0), valFmt(i))
+	}
+	st.Set(keyFmt(50), valFmt(0))
+	st.Write()
+
+	checkIterators(t, itr, itr2)
+}
+
 //-------------------------------------------------------------------------------------------
 // do some random ops
 
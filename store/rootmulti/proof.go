@@ -31,5 +31,7 @@ func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 func SMTProofRuntime() (prt *merkle.ProofRuntime) {
 	prt = merkle.NewProofRuntime()
 	prt.RegisterOpDecoder(smt.ProofType, smt.ProofDecoder)
+	prt.RegisterOpDecoder(storetypes.ProofOpSMTCommitment, storetypes.CommitmentOpDecoder)
+	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
 	return prt
 }
@@ -0,0 +1,159 @@
+package rootmulti
+
+import (
+	"sort"
+	"time"
+
+	iavltree "github.com/cosmos/iavl"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// defaultPruneBatchSize is the maximum number of heights deleted from a single store at a time
+// by the background pruner, which bounds how long a commit may have to wait for it.
+const defaultPruneBatchSize = 10
+
+// Pruning happens in the background, so that deleting IAVL versions does not delay commits.
+// Commit queues heights to prune according to the pruning options, persisting the queue along
+// with the commit metadata, and starts a background pruning run at every pruning interval.
+//
+// The pruner deletes the queued heights in batches. Each batch is deleted from one store at a
+// time while holding pruneMtx, since IAVL stores use the same write batch for commits and for
+// deleting versions. Once a batch has been deleted from all stores, it is removed from the
+// persisted queue. A crash midway through a batch thus leaves it queued, and it is deleted again
+// after a restart, which is a no-op for the stores it had already been deleted from.
+
+// startPruning starts a background pruning run, unless one is already in progress, in which case
+// it will also prune any newly queued heights. The caller must hold pruneMtx.
+func (rs *Store) startPruning() {
+	if rs.pruneDone != nil || len(rs.pruneHeights) == 0 {
+		return
+	}
+	done := make(chan struct{})
+	rs.pruneDone = done
+	go func() {
+		defer close(done)
+		rs.pruneStores()
+	}()
+}
+
+// waitForPruning waits for the background pruning run to complete, if one is in progress.
+func (rs *Store) waitForPruning() {
+	rs.pruneMtx.Lock()
+	done := rs.pruneDone
+	rs.pruneMtx.Unlock()
+	if done != nil {
+		<-done
+	}
+}
+
+// pruneStores deletes the queued heights from all IAVL stores in batches, until there are no
+// more heights to prune. If deletion fails, e.g. because a height is still being read, the
+// remaining heights are retried during the next pruning run.
+func (rs *Store) pruneStores() {
+	for {
+		heights, keys := rs.nextPruneBatch()
+		if len(heights) == 0 {
+			return
+		}
+
+		start := time.Now()
+		for _, key := range keys {
+			if err := rs.pruneStore(key, heights); err != nil {
+				rs.stopPruning()
+				return
+			}
+		}
+		if err := rs.finishPruneBatch(len(heights)); err != nil {
+			rs.stopPruning()
+			return
+		}
+		telemetry.MeasureSince(start, "store", "pruning", "batch")
+		telemetry.IncrCounter(float32(len(heights)), "store", "pruning", "pruned")
+	}
+}
+
+// nextPruneBatch returns the next batch of heights to prune along with the keys of the stores
+// to prune them from, in deterministic order. If there is nothing left to prune, the pruning run
+// is ended.
+func (rs *Store) nextPruneBatch() ([]int64, []types.StoreKey) {
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
+	if len(rs.pruneHeights) == 0 {
+		rs.pruneDone = nil
+		return nil, nil
+	}
+	n := len(rs.pruneHeights)
+	if rs.pruneBatchSize > 0 && n > rs.pruneBatchSize {
+		n = rs.pruneBatchSize
+	}
+	heights := make([]int64, n)
+	copy(heights, rs.pruneHeights)
+
+	keys := make([]types.StoreKey, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+	return heights, keys
+}
+
+// pruneStore deletes heights from a single IAVL store. Heights that no longer exist, e.g.
+// because they were deleted before a crash, are ignored.
+func (rs *Store) pruneStore(key types.StoreKey, heights []int64) error {
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
+	// The store may have been removed by an upgrade since the batch was started.
+	if _, ok := rs.stores[key]; !ok {
+		return nil
+	}
+	// If the store is wrapped with an inter-block cache, we must first unwrap
+	// it to get the underlying IAVL store.
+	store := rs.GetCommitKVStore(key).(*iavl.Store)
+
+	// DeleteVersions sorts its argument, so pass a copy.
+	versions := make([]int64, len(heights))
+	copy(versions, heights)
+	if err := store.DeleteVersions(versions...); err != nil {
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+			telemetry.IncrCounter(1, "store", "pruning", "errors")
+			return err
+		}
+	}
+	return nil
+}
+
+// finishPruneBatch removes the first n heights, which have been pruned from all stores, from the
+// queue and persists it.
+func (rs *Store) finishPruneBatch(n int) error {
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
+	rs.pruneHeights = append(make([]int64, 0, len(rs.pruneHeights)-n), rs.pruneHeights[n:]...)
+	telemetry.SetGauge(float32(len(rs.pruneHeights)), "store", "pruning", "pending")
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+	setPruningHeights(batch, rs.pruneHeights)
+	if err := batch.Write(); err != nil {
+		telemetry.IncrCounter(1, "store", "pruning", "errors")
+		return err
+	}
+	return nil
+}
+
+// stopPruning ends the pruning run early, leaving the remaining heights queued.
+func (rs *Store) stopPruning() {
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+	rs.pruneDone = nil
+}
@@ -0,0 +1,107 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// commitVersionsWithData commits n versions, writing a key to each store in every version.
+func commitVersionsWithData(ms *Store, n int) {
+	for i := 0; i < n; i++ {
+		for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+			store := ms.GetKVStore(key)
+			store.Set([]byte(fmt.Sprintf("key%d", i%50)), []byte(fmt.Sprintf("value%d", i)))
+		}
+		ms.Commit()
+	}
+}
+
+func requireVersions(t *testing.T, ms *Store, latest int64, exists func(v int64) bool) {
+	for _, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+		store := ms.GetCommitKVStore(key).(*iavl.Store)
+		for v := int64(1); v <= latest; v++ {
+			require.Equal(t, exists(v), store.VersionExists(v), "store %v version %v", key.Name(), v)
+		}
+	}
+}
+
+func TestMultiStore_PruningManyVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(10, 100, 10))
+	ms.pruneBatchSize = 7
+	require.NoError(t, ms.LoadLatestVersion())
+
+	commitVersionsWithData(ms, 1000)
+	ms.waitForPruning()
+
+	// The last pruning run happened at height 1000, pruning all heights up to 1000-1-10.
+	requireVersions(t, ms, 1000, func(v int64) bool {
+		return v >= 990 || v%100 == 0
+	})
+	require.Empty(t, ms.pruneHeights)
+	ph, err := getPruningHeights(db)
+	require.Error(t, err)
+	require.Empty(t, ph)
+
+	// The latest state is unaffected by pruning
+	require.Equal(t, []byte("value999"), ms.GetKVStore(testStoreKey1).Get([]byte("key49")))
+}
+
+func TestMultiStore_PruningCrashRecovery(t *testing.T) {
+	db := dbm.NewMemDB()
+	// A pruning interval beyond the number of versions committed queues heights without pruning.
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(5, 0, 1000))
+	require.NoError(t, ms.LoadLatestVersion())
+	commitVersionsWithData(ms, 100)
+	require.Len(t, ms.pruneHeights, 94)
+
+	// Simulate a crash midway through a batch, which was deleted from the first store but not
+	// the others, and thus was not removed from the persisted queue.
+	store1 := ms.GetCommitKVStore(testStoreKey1).(*iavl.Store)
+	require.NoError(t, store1.DeleteVersions(1, 2, 3, 4, 5, 6, 7))
+	require.False(t, store1.VersionExists(1))
+	require.True(t, ms.GetCommitKVStore(testStoreKey2).(*iavl.Store).VersionExists(1))
+
+	// After a restart, the whole queue is pruned from all stores.
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(5, 0, 1))
+	ms.pruneBatchSize = 10
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Len(t, ms.pruneHeights, 94)
+	lastCommitID := ms.LastCommitID()
+	require.Equal(t, int64(100), lastCommitID.Version)
+
+	commitVersionsWithData(ms, 1)
+	ms.waitForPruning()
+	requireVersions(t, ms, 101, func(v int64) bool {
+		return v > 95
+	})
+	require.Empty(t, ms.pruneHeights)
+
+	// A restart loads the same state, with nothing left to prune.
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(5, 0, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Empty(t, ms.pruneHeights)
+	require.Equal(t, int64(101), ms.LastCommitID().Version)
+	require.Equal(t, []byte("value0"), ms.GetKVStore(testStoreKey1).Get([]byte("key0")))
+}
+
+func TestMultiStore_PruningDuringCommits(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 0, 1))
+	ms.pruneBatchSize = 1
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// Commits proceed while pruning runs in the background, and each pruning run picks up
+	// heights queued while it is in progress.
+	commitVersionsWithData(ms, 200)
+	ms.waitForPruning()
+	requireVersions(t, ms, 200, func(v int64) bool {
+		return v >= 198
+	})
+}
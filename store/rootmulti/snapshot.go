@@ -0,0 +1,360 @@
+package rootmulti
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"sync"
+
+	iavltree "github.com/cosmos/iavl"
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// snapshotStreamBufferSize is the number of chunks each store stream may generate ahead of
+	// the format 2 snapshot output. Bounds memory usage to roughly stores * buffer * chunk size.
+	snapshotStreamBufferSize = 2
+
+	// snapshotImportBufferSize is the number of chunks buffered for each store importer during
+	// a format 2 restore.
+	snapshotImportBufferSize = 4
+)
+
+// streamChunk is a chunk generated from a single store stream, or the error that ended it.
+type streamChunk struct {
+	data []byte
+	err  error
+}
+
+// snapshotV2 generates a format 2 snapshot of the given stores. Each store is exported
+// concurrently into an independent sequence of self-contained chunks, each of which is a
+// zlib-compressed delimited Protobuf stream beginning with the store's SnapshotStoreItem. The
+// output interleaves the chunks of all stores round-robin in store order, skipping stores that
+// have run out of chunks. Since each store's chunk sequence is deterministic, so is the output.
+func (rs *Store) snapshotV2(height uint64, stores []namedStore) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	done := make(chan struct{})
+	streams := make([]chan streamChunk, 0, len(stores))
+	for _, store := range stores {
+		stream := make(chan streamChunk, snapshotStreamBufferSize)
+		streams = append(streams, stream)
+		go exportStoreStream(store, height, stream, done)
+	}
+
+	go func() {
+		defer close(ch)
+		defer close(done)
+		for len(streams) > 0 {
+			active := streams[:0]
+			for _, stream := range streams {
+				chunk, ok := <-stream
+				if !ok {
+					continue
+				}
+				if chunk.err != nil {
+					pr, pw := io.Pipe()
+					pw.CloseWithError(chunk.err)
+					ch <- pr
+					return
+				}
+				ch <- io.NopCloser(bytes.NewReader(chunk.data))
+				active = append(active, stream)
+			}
+			streams = active
+		}
+	}()
+
+	return ch
+}
+
+// exportStoreStream exports a single store into a sequence of chunks, passing them through the
+// stream channel until the export completes or done is closed.
+func exportStoreStream(store namedStore, height uint64, stream chan<- streamChunk, done <-chan struct{}) {
+	defer close(stream)
+	send := func(chunk streamChunk) bool {
+		select {
+		case stream <- chunk:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		send(streamChunk{err: err})
+		return
+	}
+	defer exporter.Close()
+
+	writer := &storeChunkWriter{name: store.name}
+	if err := writer.open(); err != nil {
+		send(streamChunk{err: err})
+		return
+	}
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
+			send(streamChunk{err: err})
+			return
+		}
+		if writer.size >= snapshotChunkSize {
+			data, err := writer.close()
+			if err != nil {
+				send(streamChunk{err: err})
+				return
+			}
+			if !send(streamChunk{data: data}) {
+				return
+			}
+			if err := writer.open(); err != nil {
+				send(streamChunk{err: err})
+				return
+			}
+		}
+		err = writer.write(&types.SnapshotItem{
+			Item: &types.SnapshotItem_IAVL{
+				IAVL: &types.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
+				},
+			},
+		})
+		if err != nil {
+			send(streamChunk{err: err})
+			return
+		}
+	}
+	data, err := writer.close()
+	if err != nil {
+		send(streamChunk{err: err})
+		return
+	}
+	send(streamChunk{data: data})
+}
+
+// storeChunkWriter serializes the snapshot items of a single store into self-contained chunks.
+// Chunks are cut once their uncompressed size reaches the chunk size, which unlike the
+// compressed size is known exactly after each item.
+type storeChunkWriter struct {
+	name        string
+	buf         *bytes.Buffer
+	protoWriter protoio.WriteCloser
+	size        uint64
+}
+
+// open starts a new chunk, beginning with the store item.
+func (w *storeChunkWriter) open() error {
+	w.buf = &bytes.Buffer{}
+	zWriter, err := zlib.NewWriterLevel(w.buf, 7)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	w.protoWriter = protoio.NewDelimitedWriter(zWriter)
+	w.size = 0
+	return w.write(&types.SnapshotItem{
+		Item: &types.SnapshotItem_Store{
+			Store: &types.SnapshotStoreItem{
+				Name: w.name,
+			},
+		},
+	})
+}
+
+// write adds an item to the current chunk.
+func (w *storeChunkWriter) write(item *types.SnapshotItem) error {
+	w.size += uint64(item.Size())
+	return w.protoWriter.WriteMsg(item)
+}
+
+// close finishes the current chunk and returns its contents.
+func (w *storeChunkWriter) close() ([]byte, error) {
+	// Closing the delimited writer also closes and flushes the zlib writer.
+	if err := w.protoWriter.Close(); err != nil {
+		return nil, err
+	}
+	return w.buf.Bytes(), nil
+}
+
+// restoreV2 imports a format 2 snapshot. Every chunk is read to find the store it belongs to,
+// and then passed on to that store's importer, which runs in its own goroutine such that all
+// stores are imported concurrently.
+func (rs *Store) restoreV2(height uint64, chunks <-chan io.ReadCloser) (err error) {
+	defer snapshots.DrainChunks(chunks)
+
+	var (
+		importers = map[string]*storeImporter{}
+		started   = []*storeImporter{}
+		failed    = make(chan error, 1)
+	)
+	defer func() {
+		// Wait for all importers to finish, committing them if everything succeeded so far.
+		for _, importer := range started {
+			importer.finish(err == nil)
+		}
+		for _, importer := range started {
+			if e := importer.wait(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}()
+
+	for chunk := range chunks {
+		select {
+		case err := <-failed:
+			return err
+		default:
+		}
+
+		data, err := io.ReadAll(chunk)
+		if err != nil {
+			chunk.Close()
+			return sdkerrors.Wrap(err, "failed to read snapshot chunk")
+		}
+		if err = chunk.Close(); err != nil {
+			return err
+		}
+
+		name, protoReader, err := openStoreChunk(data)
+		if err != nil {
+			return err
+		}
+		importer, ok := importers[name]
+		if !ok {
+			store, ok := rs.getStoreByName(name).(*iavl.Store)
+			if !ok || store == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", name)
+			}
+			importer, err = newStoreImporter(store, height, failed)
+			if err != nil {
+				return err
+			}
+			importers[name] = importer
+			started = append(started, importer)
+		}
+		importer.chunks <- protoReader
+	}
+	return nil
+}
+
+// openStoreChunk opens a format 2 chunk, returning the name of the store it belongs to and a
+// reader positioned at the chunk's first IAVL item.
+func openStoreChunk(data []byte) (string, protoio.ReadCloser, error) {
+	zReader, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", nil, sdkerrors.Wrap(err, "zlib failure")
+	}
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	item := &types.SnapshotItem{}
+	if err := protoReader.ReadMsg(item); err != nil {
+		protoReader.Close()
+		return "", nil, sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+	storeItem, ok := item.Item.(*types.SnapshotItem_Store)
+	if !ok {
+		protoReader.Close()
+		return "", nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected store item at start of chunk, got %T", item.Item)
+	}
+	return storeItem.Store.Name, protoReader, nil
+}
+
+// storeImporter imports the chunks of a single store in a separate goroutine.
+type storeImporter struct {
+	importer *iavltree.Importer
+	chunks   chan protoio.ReadCloser
+	commit   bool
+	done     chan struct{}
+	err      error
+	once     sync.Once
+}
+
+// newStoreImporter starts a store import. The first import error is also sent to failed, if
+// it is not already full, so that the restore can be aborted early.
+func newStoreImporter(store *iavl.Store, height uint64, failed chan<- error) (*storeImporter, error) {
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "import failed")
+	}
+	s := &storeImporter{
+		importer: importer,
+		chunks:   make(chan protoio.ReadCloser, snapshotImportBufferSize),
+		done:     make(chan struct{}),
+	}
+	go func() {
+		defer close(s.done)
+		defer importer.Close()
+		s.err = s.run()
+		if s.err != nil {
+			select {
+			case failed <- s.err:
+			default:
+			}
+		}
+	}()
+	return s, nil
+}
+
+// run imports chunks until the chunk channel is closed. On errors, remaining chunks are
+// discarded so that the sender never blocks.
+func (s *storeImporter) run() error {
+	for protoReader := range s.chunks {
+		err := importChunk(s.importer, protoReader)
+		protoReader.Close()
+		if err != nil {
+			for protoReader := range s.chunks {
+				protoReader.Close()
+			}
+			return err
+		}
+	}
+	if !s.commit {
+		return nil
+	}
+	if err := s.importer.Commit(); err != nil {
+		return sdkerrors.Wrap(err, "IAVL commit failed")
+	}
+	return nil
+}
+
+// finish signals that there are no more chunks. The import is only committed if commit is true.
+func (s *storeImporter) finish(commit bool) {
+	s.once.Do(func() {
+		s.commit = commit
+		close(s.chunks)
+	})
+}
+
+// wait waits for the importer to finish, returning its error.
+func (s *storeImporter) wait() error {
+	<-s.done
+	return s.err
+}
+
+// importChunk imports the IAVL items of a single chunk.
+func importChunk(importer *iavltree.Importer, protoReader protoio.Reader) error {
+	for {
+		item := &types.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		iavlItem, ok := item.Item.(*types.SnapshotItem_IAVL)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T in store chunk", item.Item)
+		}
+		if err := importIAVLItem(importer, iavlItem.IAVL); err != nil {
+			return err
+		}
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -54,10 +55,15 @@ type Store struct {
 	stores         map[types.StoreKey]types.CommitKVStore
 	keysByName     map[string]types.StoreKey
 	lazyLoading    bool
-	pruneHeights   []int64
 	initialVersion int64
 	removalMap     map[types.StoreKey]bool
 
+	// pruneMtx guards the pruning state, and serializes commits with the background pruner.
+	pruneMtx       sync.Mutex
+	pruneHeights   []int64
+	pruneBatchSize int
+	pruneDone      chan struct{} // non-nil while a background pruning run is in progress
+
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
 	traceContextMutex sync.Mutex
@@ -78,15 +84,16 @@ var (
 // LoadVersion must be called.
 func NewStore(db dbm.DB) *Store {
 	return &Store{
-		db:            db,
-		pruningOpts:   types.PruneNothing,
-		iavlCacheSize: iavl.DefaultIAVLCacheSize,
-		storesParams:  make(map[types.StoreKey]storeParams),
-		stores:        make(map[types.StoreKey]types.CommitKVStore),
-		keysByName:    make(map[string]types.StoreKey),
-		pruneHeights:  make([]int64, 0),
-		listeners:     make(map[types.StoreKey][]types.WriteListener),
-		removalMap:    make(map[types.StoreKey]bool),
+		db:             db,
+		pruningOpts:    types.PruneNothing,
+		iavlCacheSize:  iavl.DefaultIAVLCacheSize,
+		storesParams:   make(map[types.StoreKey]storeParams),
+		stores:         make(map[types.StoreKey]types.CommitKVStore),
+		keysByName:     make(map[string]types.StoreKey),
+		listeners:      make(map[types.StoreKey][]types.WriteListener),
+		removalMap:     make(map[types.StoreKey]bool),
+		pruneHeights:   make([]int64, 0),
+		pruneBatchSize: defaultPruneBatchSize,
 	}
 }
 
@@ -179,6 +186,9 @@ func (rs *Store) LoadVersion(ver int64) error {
 }
 
 func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
+	// The background pruner must not operate on the stores while they are replaced.
+	rs.waitForPruning()
+
 	infos := make(map[string]types.StoreInfo)
 
 	cInfo := &types.CommitInfo{}
@@ -395,6 +405,9 @@ func (rs *Store) LastCommitID() types.CommitID {
 
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
+	rs.pruneMtx.Lock()
+	defer rs.pruneMtx.Unlock()
+
 	var previousHeight, version int64
 	if rs.lastCommitInfo.GetVersion() == 0 && rs.initialVersion > 1 {
 		// This case means that no commit has been made in the store, we
@@ -438,43 +451,20 @@ func (rs *Store) Commit() types.CommitID {
 		}
 	}
 
-	// batch prune if the current height is a pruning interval height
+	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
+	telemetry.SetGauge(float32(len(rs.pruneHeights)), "store", "pruning", "pending")
+
+	// batch prune in the background if the current height is a pruning interval height
 	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
-		rs.pruneStores()
+		rs.startPruning()
 	}
 
-	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
-
 	return types.CommitID{
 		Version: version,
 		Hash:    rs.lastCommitInfo.Hash(),
 	}
 }
 
-// pruneStores will batch delete a list of heights from each mounted sub-store.
-// Afterwards, pruneHeights is reset.
-func (rs *Store) pruneStores() {
-	if len(rs.pruneHeights) == 0 {
-		return
-	}
-
-	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
-				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
-					panic(err)
-				}
-			}
-		}
-	}
-
-	rs.pruneHeights = make([]int64, 0)
-}
-
 // CacheWrap implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -678,7 +668,7 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	if format != snapshottypes.CurrentFormat {
+	if !snapshottypes.IsFormatSupported(rs.SupportedFormats(), format) {
 		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -689,10 +679,6 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
 	stores := []namedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
@@ -710,6 +696,26 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
 
+	if format == snapshottypes.FormatV2 {
+		return rs.snapshotV2(height, stores), nil
+	}
+	return rs.snapshotV1(height, stores), nil
+}
+
+// SupportedFormats implements snapshottypes.Snapshotter.
+func (rs *Store) SupportedFormats() []uint32 {
+	return []uint32{snapshottypes.FormatV1, snapshottypes.FormatV2}
+}
+
+// namedStore is an IAVL store to snapshot, along with its name.
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotV1 generates a format 1 snapshot of the given stores, which is a single stream split
+// into fixed-size chunks.
+func (rs *Store) snapshotV1(height uint64, stores []namedStore) <-chan io.ReadCloser {
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go func() {
@@ -790,14 +796,14 @@ func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, e
 		}
 	}()
 
-	return ch, nil
+	return ch
 }
 
 // Restore implements snapshottypes.Snapshotter.
 func (rs *Store) Restore(
 	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
 ) error {
-	if format != snapshottypes.CurrentFormat {
+	if !snapshottypes.IsFormatSupported(rs.SupportedFormats(), format) {
 		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 	if height == 0 {
@@ -814,6 +820,22 @@ func (rs *Store) Restore(
 		close(ready)
 	}
 
+	var err error
+	if format == snapshottypes.FormatV2 {
+		err = rs.restoreV2(height, chunks)
+	} else {
+		err = rs.restoreV1(height, chunks)
+	}
+	if err != nil {
+		return err
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	return rs.LoadLatestVersion()
+}
+
+// restoreV1 imports a format 1 snapshot, which is a single stream spanning all stores.
+func (rs *Store) restoreV1(height uint64, chunks <-chan io.ReadCloser) error {
 	// Set up a restore stream pipeline
 	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 	chunkReader := snapshots.NewChunkReader(chunks)
@@ -862,27 +884,8 @@ func (rs *Store) Restore(
 			if importer == nil {
 				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := importIAVLItem(importer, item.IAVL); err != nil {
+				return err
 			}
 
 		default:
@@ -897,9 +900,34 @@ func (rs *Store) Restore(
 		}
 		importer.Close()
 	}
+	return nil
+}
 
-	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return rs.LoadLatestVersion()
+// importIAVLItem adds an exported IAVL node from a snapshot item to an importer.
+func importIAVLItem(importer *iavltree.Importer, item *types.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	err := importer.Add(node)
+	if err != nil {
+		return sdkerrors.Wrap(err, "IAVL node import failed")
+	}
+	return nil
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
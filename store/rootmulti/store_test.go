@@ -504,6 +504,7 @@ func TestMultiStore_Pruning(t *testing.T) {
 			for i := int64(0); i < tc.numVersions; i++ {
 				ms.Commit()
 			}
+			ms.waitForPruning()
 
 			for _, v := range tc.saved {
 				_, err := ms.CacheMultiStoreWithVersion(v)
@@ -544,6 +545,7 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 
 	// commit one more block and ensure the heights have been pruned
 	ms.Commit()
+	ms.waitForPruning()
 	require.Empty(t, ms.pruneHeights)
 
 	for _, v := range pruneHeights {
@@ -572,6 +574,18 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"ca2879ac6e7205d257440131ba7e72bef784cd61642e32b847729e543c1928b9",
 		}},
+		{2, []string{
+			"1b20a5580e5e0617d852465936a07d9f5ca4b1610142560ed38d64e3a3cb95a3",
+			"c407ff8b918c603b61bbea9f9bda66bed57c22d8ce0fc05dc3d61295674df0d7",
+			"d2db66c1c65d093f3eb4ec38c17d206c93631ee2ab20a0ce0cacfe2e6c0ffecf",
+			"3bf7cf4d170004046ed050552df1a59b6f45ba3d8b025013812b8ebc92cad0dc",
+			"32c706555a531c7014f13f1bee721edff4afe981c6f7bdd223d045681309590d",
+			"e52a6c825e0c8381180de654be1c96b37a8064533f1e3c3499650f04777a005a",
+			"68d3666d152e653b6cea246f7f12006d6436a77a4ba5053dffe7e9315a104b63",
+			"f0334351f9d8ac752394c9889ce70195b9f5665caa0ca1a2a09021405428ace1",
+			"8f86dc373572bf90a8414ee39b34d6697080134ae5c6432966df62f1ea3f4be0",
+			"391e2da9f9acee16c5906c79db77944f41997a3d749ddc0a3e7791a6dd901968",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -642,29 +656,87 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 }
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
-	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
-	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	for _, format := range []uint32{snapshottypes.FormatV1, snapshottypes.FormatV2} {
+		format := format
+		t.Run(fmt.Sprintf("Format %v", format), func(t *testing.T) {
+			source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+			target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+			version := uint64(source.LastCommitID().Version)
+			require.EqualValues(t, 3, version)
+
+			chunks, err := source.Snapshot(version, format)
+			require.NoError(t, err)
+			ready := make(chan struct{})
+			err = target.Restore(version, format, chunks, ready)
+			require.NoError(t, err)
+			assert.EqualValues(t, struct{}{}, <-ready)
+
+			assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+			for key, sourceStore := range source.stores {
+				targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+				switch sourceStore.GetStoreType() {
+				case types.StoreTypeTransient:
+					assert.False(t, targetStore.Iterator(nil, nil).Valid(),
+						"transient store %v not empty", key.Name())
+				default:
+					assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore_FormatV2Chunks(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 1000)
 	version := uint64(source.LastCommitID().Version)
-	require.EqualValues(t, 3, version)
 
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	// Every chunk must be a self-contained stream for a single store, with the chunks of all
+	// stores interleaved in store order.
+	chunks, err := source.Snapshot(version, snapshottypes.FormatV2)
 	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	names := []string{}
+	for chunk := range chunks {
+		data, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		name, reader, err := openStoreChunk(data)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		names = append(names, name)
+	}
+	require.Equal(t, []string{"store0", "store1", "store2"}, names)
+
+	// Chunks of different stores are independent, so their relative order does not matter.
+	chunks, err = source.Snapshot(version, snapshottypes.FormatV2)
 	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
+	var first io.ReadCloser
+	reordered := make(chan io.ReadCloser, 3)
+	for chunk := range chunks {
+		if first == nil {
+			first = chunk
+			continue
+		}
+		reordered <- chunk
+	}
+	reordered <- first
+	close(reordered)
 
+	target := NewStore(dbm.NewMemDB())
+	for key := range source.stores {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	require.NoError(t, target.Restore(version, snapshottypes.FormatV2, reordered, nil))
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
-	for key, sourceStore := range source.stores {
-		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
-		switch sourceStore.GetStoreType() {
-		case types.StoreTypeTransient:
-			assert.False(t, targetStore.Iterator(nil, nil).Valid(),
-				"transient store %v not empty", key.Name())
-		default:
-			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
-		}
+
+	// Restoring a format 1 stream as format 2 must fail.
+	chunks, err = source.Snapshot(version, snapshottypes.FormatV1)
+	require.NoError(t, err)
+	target = NewStore(dbm.NewMemDB())
+	for key := range source.stores {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
 	}
+	require.NoError(t, target.LoadLatestVersion())
+	require.Error(t, target.Restore(version, snapshottypes.FormatV2, chunks, nil))
 }
 
 func TestSetInitialVersion(t *testing.T) {
@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/kafka"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +25,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	Plugin
+	Kafka
 	// add more in the future
 )
 
@@ -31,6 +35,10 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "plugin", "p":
+		return Plugin
+	case "kafka", "k":
+		return Kafka
 	default:
 		return Unknown
 	}
@@ -41,6 +49,10 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case Plugin:
+		return "plugin"
+	case Kafka:
+		return "kafka"
 	default:
 		return "unknown"
 	}
@@ -48,7 +60,9 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:   NewFileStreamingService,
+	Plugin: NewPluginStreamingService,
+	Kafka:  NewKafkaStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -70,6 +84,27 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewPluginStreamingService is the streaming.ServiceConstructor function for creating a plugin StreamingService
+func NewPluginStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	pluginPath := cast.ToString(opts.Get("streamers.plugin.path"))
+	bufferSize := cast.ToInt(opts.Get("streamers.plugin.buffer_size"))
+	haltOnLag := cast.ToInt64(opts.Get("streamers.plugin.halt_on_lag"))
+	return plugin.NewStreamingService(pluginPath, bufferSize, haltOnLag, keys)
+}
+
+// NewKafkaStreamingService is the streaming.ServiceConstructor function for creating a kafka StreamingService
+func NewKafkaStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	config := kafka.Config{
+		Brokers:       cast.ToStringSlice(opts.Get("streamers.kafka.brokers")),
+		TopicPrefix:   cast.ToString(opts.Get("streamers.kafka.topic_prefix")),
+		Topics:        cast.ToStringMapString(opts.Get("streamers.kafka.topics")),
+		BatchSize:     cast.ToInt(opts.Get("streamers.kafka.batch_size")),
+		FlushInterval: cast.ToDuration(opts.Get("streamers.kafka.flush_interval")),
+		Ack:           cast.ToString(opts.Get("streamers.kafka.ack")),
+	}
+	return kafka.NewStreamingService(config, keys, marshaller)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
@@ -77,6 +112,8 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 	wg := new(sync.WaitGroup)
 	// configure state listening capabilities using AppOptions
 	streamers := cast.ToStringSlice(appOpts.Get("store.streamers"))
+	// halt the node instead of only logging the errors of the streaming services, so that no data is silently missed
+	bApp.SetStopNodeOnStreamingErr(cast.ToBool(appOpts.Get("store.stop_node_on_streaming_err")))
 	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
 	for _, streamerName := range streamers {
 		// get the store keys allowed to be exposed for this streaming service
@@ -41,3 +41,21 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+func TestPluginStreamingServiceConstructor(t *testing.T) {
+	constructor, err := NewServiceConstructor("plugin")
+	require.Nil(t, err)
+
+	// the plugin path is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+}
+
+func TestKafkaStreamingServiceConstructor(t *testing.T) {
+	constructor, err := NewServiceConstructor("kafka")
+	require.Nil(t, err)
+
+	// the brokers are required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+}
@@ -22,7 +22,6 @@ var _ baseapp.StreamingService = &StreamingService{}
 // StreamingService is a concrete implementation of StreamingService that writes state changes out to files
 type StreamingService struct {
 	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
-	srcChan            <-chan []byte                            // the channel that all the WriteListeners write their data out to
 	filePrefix         string                                   // optional prefix for each of the generated files
 	writeDir           string                                   // directory to write files into
 	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
@@ -52,30 +51,42 @@ func (iw *IntermediateWriter) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+// stateCacheWriter is the io.Writer the WriteListeners write the StoreKVPairs to. It appends them to the
+// state cache synchronously so that they are all cached when the ABCI message is received.
+type stateCacheWriter struct {
+	fss *StreamingService
+}
+
+// Write satisfies io.Writer
+func (w stateCacheWriter) Write(b []byte) (int, error) {
+	w.fss.stateCacheLock.Lock()
+	w.fss.stateCache = append(w.fss.stateCache, b)
+	w.fss.stateCacheLock.Unlock()
+	return len(b), nil
+}
+
 // NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
 func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
-	listenChan := make(chan []byte)
-	iw := NewIntermediateWriter(listenChan)
-	listener := types.NewStoreKVPairWriteListener(iw, c)
-	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
-	// in this case, we are using the same listener for each Store
-	for _, key := range storeKeys {
-		listeners[key] = append(listeners[key], listener)
-	}
 	// check that the writeDir exists and is writeable so that we can catch the error here at initialization if it is not
 	// we don't open a dstFile until we receive our first ABCI message
 	if err := isDirWriteable(writeDir); err != nil {
 		return nil, err
 	}
-	return &StreamingService{
-		listeners:      listeners,
-		srcChan:        listenChan,
+	fss := &StreamingService{
 		filePrefix:     filePrefix,
 		writeDir:       writeDir,
 		codec:          c,
 		stateCache:     make([][]byte, 0),
 		stateCacheLock: new(sync.Mutex),
-	}, nil
+	}
+	listener := types.NewStoreKVPairWriteListener(stateCacheWriter{fss: fss}, c)
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		listeners[key] = append(listeners[key], listener)
+	}
+	fss.listeners = listeners
+	return fss, nil
 }
 
 // Listeners satisfies the baseapp.StreamingService interface
@@ -89,182 +100,127 @@ func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListene
 // It writes the received BeginBlock request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
-	// generate the new file
-	dstFile, err := fss.openBeginBlockFile(req)
-	if err != nil {
-		return err
-	}
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
-		return err
-	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
-		return err
-	}
-	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
-			return err
-		}
-	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
-	if err != nil {
-		return err
-	}
-	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
-		return err
-	}
-	// close file
-	return dstFile.Close()
-}
-
-func (fss *StreamingService) openBeginBlockFile(req abci.RequestBeginBlock) (*os.File, error) {
 	fss.currentBlockNumber = req.GetHeader().Height
 	fss.currentTxIndex = 0
-	fileName := fmt.Sprintf("block-%d-begin", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
+	return fss.writeFile(fmt.Sprintf("block-%d-begin", fss.currentBlockNumber), &req, fss.popStateCache(), &res)
 }
 
 // ListenDeliverTx satisfies the baseapp.ABCIListener interface
 // It writes the received DeliverTx request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
-	// generate the new file
-	dstFile, err := fss.openDeliverTxFile()
-	if err != nil {
-		return err
-	}
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
-		return err
-	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
-		return err
-	}
-	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
-			return err
-		}
-	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
-	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
-	if err != nil {
-		return err
-	}
-	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
-		return err
-	}
-	// close file
-	return dstFile.Close()
-}
-
-func (fss *StreamingService) openDeliverTxFile() (*os.File, error) {
 	fileName := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
 	fss.currentTxIndex++
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
+	return fss.writeFile(fileName, &req, fss.popStateCache(), &res)
 }
 
 // ListenEndBlock satisfies the baseapp.ABCIListener interface
 // It writes the received EndBlock request and response and the resulting state changes
 // out to a file as described in the above the naming schema
 func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
-	// generate the new file
-	dstFile, err := fss.openEndBlockFile()
+	return fss.writeFile(fmt.Sprintf("block-%d-end", fss.currentBlockNumber), &req, fss.popStateCache(), &res)
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the received Commit response and all the state changes committed in the block
+// out to a file as described in the above the naming schema. The file is written last, once
+// all the other files of the block have been written, so that its presence marks the block
+// as complete
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	// the state changes cached since EndBlock are the ones written when committing the block,
+	// which are already part of the change set
+	fss.popStateCache()
+	encodedChangeSet := make([][]byte, len(changeSet))
+	for i, pair := range changeSet {
+		by, err := fss.codec.MarshalLengthPrefixed(pair)
+		if err != nil {
+			return err
+		}
+		encodedChangeSet[i] = by
+	}
+	return fss.writeFile(fmt.Sprintf("block-%d-commit", fss.currentBlockNumber), nil, encodedChangeSet, &res)
+}
+
+// writeFile writes the length-prefixed req, if any, state changes and res to the named file.
+// The file is first written under a temporary name and renamed once it has been synced to
+// disk, so that readers never observe a partially written file
+func (fss *StreamingService) writeFile(fileName string, req codec.ProtoMarshaler, stateChanges [][]byte, res codec.ProtoMarshaler) error {
+	if fss.filePrefix != "" {
+		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	}
+	filePath := filepath.Join(fss.writeDir, fileName)
+	tmpPath := filepath.Join(fss.writeDir, "."+fileName+".tmp")
+	dstFile, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
 	if err != nil {
 		return err
 	}
-	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
-	if err != nil {
+	if err := fss.writeMessages(dstFile, req, stateChanges, res); err != nil {
+		dstFile.Close()
+		os.Remove(tmpPath)
 		return err
 	}
-	if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
+	if err := dstFile.Close(); err != nil {
+		os.Remove(tmpPath)
 		return err
 	}
+	return os.Rename(tmpPath, filePath)
+}
+
+func (fss *StreamingService) writeMessages(dstFile *os.File, req codec.ProtoMarshaler, stateChanges [][]byte, res codec.ProtoMarshaler) error {
+	// write req to file
+	if req != nil {
+		lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(req)
+		if err != nil {
+			return err
+		}
+		if _, err = dstFile.Write(lengthPrefixedReqBytes); err != nil {
+			return err
+		}
+	}
 	// write all state changes cached for this stage to file
-	fss.stateCacheLock.Lock()
-	for _, stateChange := range fss.stateCache {
-		if _, err = dstFile.Write(stateChange); err != nil {
-			fss.stateCache = nil
-			fss.stateCacheLock.Unlock()
+	for _, stateChange := range stateChanges {
+		if _, err := dstFile.Write(stateChange); err != nil {
 			return err
 		}
 	}
-	// reset cache
-	fss.stateCache = nil
-	fss.stateCacheLock.Unlock()
 	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(res)
 	if err != nil {
 		return err
 	}
 	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
 		return err
 	}
-	// close file
-	return dstFile.Close()
+	return dstFile.Sync()
 }
 
-func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-end", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
+// popStateCache returns the cached state changes and resets the cache
+func (fss *StreamingService) popStateCache() [][]byte {
+	fss.stateCacheLock.Lock()
+	defer fss.stateCacheLock.Unlock()
+	stateChanges := fss.stateCache
+	fss.stateCache = nil
+	return stateChanges
 }
 
 // Stream satisfies the baseapp.StreamingService interface
-// It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
-// and caches them in the order they were received
+// The state changes are cached synchronously as they are written, so there is no
+// background loop to start
 // returns an error if it is called twice
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 	if fss.quitChan != nil {
 		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
 	}
 	fss.quitChan = make(chan struct{})
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for {
-			select {
-			case <-fss.quitChan:
-				fss.quitChan = nil
-				return
-			case by := <-fss.srcChan:
-				fss.stateCacheLock.Lock()
-				fss.stateCache = append(fss.stateCache, by)
-				fss.stateCacheLock.Unlock()
-			}
-		}
-	}()
 	return nil
 }
 
 // Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
 func (fss *StreamingService) Close() error {
-	close(fss.quitChan)
+	if fss.quitChan != nil {
+		close(fss.quitChan)
+		fss.quitChan = nil
+	}
 	return nil
 }
 
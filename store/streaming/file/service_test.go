@@ -57,6 +57,9 @@ var (
 		ConsensusParamUpdates: &types1.ConsensusParams{},
 		ValidatorUpdates:      []abci.ValidatorUpdate{},
 	}
+	testCommitRes = abci.ResponseCommit{
+		Data: mockHash,
+	}
 	mockTxBytes1      = []byte{9, 8, 7, 6, 5, 4, 3, 2, 1}
 	testDeliverTxReq1 = abci.RequestDeliverTx{
 		Tx: mockTxBytes1,
@@ -147,6 +150,7 @@ func TestFileStreamingService(t *testing.T) {
 	testListenDeliverTx1(t)
 	testListenDeliverTx2(t)
 	testListenEndBlock(t)
+	testListenCommit(t)
 	testStreamingService.Close()
 	wg.Wait()
 }
@@ -367,6 +371,45 @@ func testListenEndBlock(t *testing.T) {
 	require.Equal(t, expectedEndBlockResBytes, segments[4])
 }
 
+func testListenCommit(t *testing.T) {
+	expectedCommitResBytes, err := testMarshaller.Marshal(&testCommitRes)
+	require.Nil(t, err)
+
+	// the state changes written when committing are only part of the change set
+	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Delete: true},
+	}
+	expectedKVPair1, err := testMarshaller.Marshal(changeSet[0])
+	require.Nil(t, err)
+	expectedKVPair2, err := testMarshaller.Marshal(changeSet[1])
+	require.Nil(t, err)
+
+	// send the ABCI messages
+	err = testStreamingService.ListenCommit(emptyContext, testCommitRes, changeSet)
+	require.Nil(t, err)
+	require.Empty(t, testStreamingService.stateCache)
+
+	// load the file, checking that it was created with the expected name
+	fileName := fmt.Sprintf("%s-block-%d-commit", testPrefix, testBeginBlockReq.GetHeader().Height)
+	fileBytes, err := readInFile(fileName)
+	require.Nil(t, err)
+
+	// segment the file into the separate gRPC messages and check the correctness of each
+	segments, err := segmentBytes(fileBytes)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(segments))
+	require.Equal(t, expectedKVPair1, segments[0])
+	require.Equal(t, expectedKVPair2, segments[1])
+	require.Equal(t, expectedCommitResBytes, segments[2])
+
+	// no temporary file is left behind
+	files, err := ioutil.ReadDir(testDir)
+	require.Nil(t, err)
+	require.Equal(t, 5, len(files))
+}
+
 func readInFile(name string) ([]byte, error) {
 	path := filepath.Join(testDir, name)
 	return ioutil.ReadFile(path)
@@ -0,0 +1,355 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// ErrNotRunning is returned when producing to or waiting for the deliveries of a StreamingService
+// which is not running
+var ErrNotRunning = errors.New("the kafka streaming service is not running")
+
+// Topics of the ABCI messages, prefixed by Config.TopicPrefix
+const (
+	BeginBlockTopic = "begin-block"
+	DeliverTxTopic  = "deliver-tx"
+	EndBlockTopic   = "end-block"
+	CommitTopic     = "commit"
+)
+
+// Headers set on the produced messages
+const (
+	BlockHeightHeader = "block_height"
+	TxIndexHeader     = "tx_index"
+)
+
+// Acknowledgement levels of the produced messages
+const (
+	AckNone   = "none"
+	AckLeader = "leader"
+	AckAll    = "all"
+)
+
+// DefaultFlushInterval is the flush interval used when a batch size is set without flush interval, so that
+// incomplete batches are eventually flushed
+const DefaultFlushInterval = 100 * time.Millisecond
+
+// Config defines the configuration of the kafka StreamingService
+type Config struct {
+	// Brokers is the list of the addresses of the kafka brokers to connect to
+	Brokers []string
+	// TopicPrefix is the optional prefix of all the topics
+	TopicPrefix string
+	// Topics maps store key names to the topics their state changes are produced to, prefixed by TopicPrefix.
+	// The state changes of the other stores are produced to the "state-{store key}" topic
+	Topics map[string]string
+	// BatchSize is the number of messages which triggers a flush of the producer, 0 to only rely on FlushInterval
+	BatchSize int
+	// FlushInterval is the maximum time messages are buffered before being flushed. If 0, the messages are
+	// flushed immediately when BatchSize is 0 and after DefaultFlushInterval otherwise
+	FlushInterval time.Duration
+	// Ack is the acknowledgement required from the brokers for a message to be delivered: "none", "leader" or "all"
+	Ack string
+	// Sarama is the base configuration of the producer, the other fields take precedence over it.
+	// It defaults to sarama.NewConfig()
+	Sarama *sarama.Config
+}
+
+// producerConfig returns the sarama configuration of the producer
+func (c Config) producerConfig() (*sarama.Config, error) {
+	if len(c.Brokers) == 0 {
+		return nil, errors.New("at least one kafka broker is required")
+	}
+	if c.BatchSize < 0 {
+		return nil, fmt.Errorf("batch size cannot be negative: %d", c.BatchSize)
+	}
+	if c.FlushInterval < 0 {
+		return nil, fmt.Errorf("flush interval cannot be negative: %s", c.FlushInterval)
+	}
+	config := c.Sarama
+	if config == nil {
+		config = sarama.NewConfig()
+	}
+	switch strings.ToLower(c.Ack) {
+	case AckNone:
+		config.Producer.RequiredAcks = sarama.NoResponse
+	case AckLeader:
+		config.Producer.RequiredAcks = sarama.WaitForLocal
+	case AckAll, "":
+		config.Producer.RequiredAcks = sarama.WaitForAll
+	default:
+		return nil, fmt.Errorf("unknown ack %s, expected one of %s, %s, %s", c.Ack, AckNone, AckLeader, AckAll)
+	}
+	config.Producer.Flush.Messages = c.BatchSize
+	config.Producer.Flush.Frequency = c.FlushInterval
+	if c.BatchSize > 0 && c.FlushInterval == 0 {
+		config.Producer.Flush.Frequency = DefaultFlushInterval
+	}
+	// the delivery of every message is tracked to only acknowledge complete blocks
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+	return config, config.Validate()
+}
+
+// StreamingService is a concrete implementation of StreamingService that produces the ABCI messages and the state
+// changes committed in each block to kafka topics.
+//
+// The ABCI messages are produced as soon as they are received, the state changes when the block is committed. The
+// message of the Commit topic is only produced once all the other messages of the block have been delivered, and
+// ListenCommit returns once it has been delivered, so that a block is never acknowledged before all its messages are.
+type StreamingService struct {
+	listeners      map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	topicPrefix    string                                   // optional prefix of the topics
+	storeTopics    map[string]string                        // the topics of the state changes by store key name
+	codec          codec.BinaryCodec                        // marshaller used for marshalling the messages
+	producer       sarama.AsyncProducer                     // the kafka producer
+	pendingCond    *sync.Cond                               // signals the deliveries of the messages
+	pending        int                                      // the number of messages produced and not delivered yet
+	running        bool                                     // whether the deliveries are tracked, between Stream and Close
+	err            error                                    // the first delivery error since the last commit
+	currentTxIndex int64                                    // the index of the current tx
+	quitChan       chan struct{}                            // channel to synchronize closure
+}
+
+// NewStreamingService creates a new StreamingService producing the state changes of the provided storeKeys to the
+// kafka brokers of the config
+func NewStreamingService(config Config, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
+	producerConfig, err := config.producerConfig()
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewAsyncProducer(config.Brokers, producerConfig)
+	if err != nil {
+		return nil, err
+	}
+	// the state changes are taken from the change set of ListenCommit, no WriteListener is needed
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	storeTopics := make(map[string]string, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = nil
+		topic, ok := config.Topics[key.Name()]
+		if !ok {
+			topic = "state-" + key.Name()
+		}
+		storeTopics[key.Name()] = topicName(config.TopicPrefix, topic)
+	}
+	return &StreamingService{
+		listeners:   listeners,
+		topicPrefix: config.TopicPrefix,
+		storeTopics: storeTopics,
+		codec:       c,
+		producer:    producer,
+		pendingCond: sync.NewCond(new(sync.Mutex)),
+	}, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (kss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return kss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It produces the received BeginBlock request and response to the BeginBlock topic, or returns
+// ErrNotRunning if the service is not running
+func (kss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	if !kss.isRunning() {
+		return ErrNotRunning
+	}
+	kss.currentTxIndex = 0
+	return kss.produce(BeginBlockTopic, req.Header.Height, &plugin.ListenBeginBlockRequest{Req: req, Res: res})
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It produces the received DeliverTx request and response to the DeliverTx topic, or returns
+// ErrNotRunning if the service is not running
+func (kss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !kss.isRunning() {
+		return ErrNotRunning
+	}
+	txIndex := kss.currentTxIndex
+	kss.currentTxIndex++
+	return kss.produce(DeliverTxTopic, ctx.BlockHeight(), &plugin.ListenDeliverTxRequest{Req: req, Res: res},
+		sarama.RecordHeader{Key: []byte(TxIndexHeader), Value: []byte(strconv.FormatInt(txIndex, 10))})
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It produces the received EndBlock request and response to the EndBlock topic, or returns
+// ErrNotRunning if the service is not running
+func (kss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if !kss.isRunning() {
+		return ErrNotRunning
+	}
+	return kss.produce(EndBlockTopic, req.Height, &plugin.ListenEndBlockRequest{Req: req, Res: res})
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It produces the state changes committed in the block to the topics of their stores, keyed by the
+// KVStore key, then the Commit response to the Commit topic once all the messages of the block have
+// been delivered. It returns an error if any message of the block could not be delivered, or
+// ErrNotRunning if the service is not running
+func (kss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	if !kss.isRunning() {
+		return ErrNotRunning
+	}
+	height := ctx.BlockHeight()
+	for _, pair := range changeSet {
+		topic, ok := kss.storeTopics[pair.StoreKey]
+		if !ok {
+			continue
+		}
+		value, err := kss.codec.Marshal(pair)
+		if err != nil {
+			return err
+		}
+		kss.send(&sarama.ProducerMessage{
+			Topic:   topic,
+			Key:     sarama.ByteEncoder(pair.Key),
+			Value:   sarama.ByteEncoder(value),
+			Headers: []sarama.RecordHeader{blockHeightHeader(height)},
+		})
+	}
+	if err := kss.waitDeliveries(); err != nil {
+		return err
+	}
+	if err := kss.produce(CommitTopic, height, &plugin.ListenCommitRequest{BlockHeight: height, Res: res}); err != nil {
+		return err
+	}
+	return kss.waitDeliveries()
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It spins up a goroutine which tracks the deliveries of the produced messages
+// returns an error if it is called twice
+func (kss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if kss.quitChan != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	kss.quitChan = make(chan struct{})
+	kss.setRunning(true)
+	successes, errs := kss.producer.Successes(), kss.producer.Errors()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer kss.setRunning(false)
+		// the channels are closed once the producer is closed and all the messages have been processed
+		for successes != nil || errs != nil {
+			select {
+			case _, ok := <-successes:
+				if !ok {
+					successes = nil
+					continue
+				}
+				kss.delivered(nil)
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				kss.delivered(err)
+			}
+		}
+	}()
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It flushes the buffered messages and closes the producer
+func (kss *StreamingService) Close() error {
+	if kss.quitChan != nil {
+		close(kss.quitChan)
+		kss.quitChan = nil
+	}
+	err := kss.producer.Close()
+	kss.setRunning(false)
+	return err
+}
+
+func (kss *StreamingService) produce(topic string, height int64, msg codec.ProtoMarshaler, headers ...sarama.RecordHeader) error {
+	value, err := kss.codec.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	kss.send(&sarama.ProducerMessage{
+		Topic:   topicName(kss.topicPrefix, topic),
+		Key:     sarama.StringEncoder(strconv.FormatInt(height, 10)),
+		Value:   sarama.ByteEncoder(value),
+		Headers: append([]sarama.RecordHeader{blockHeightHeader(height)}, headers...),
+	})
+	return nil
+}
+
+func (kss *StreamingService) send(msg *sarama.ProducerMessage) {
+	kss.pendingCond.L.Lock()
+	kss.pending++
+	kss.pendingCond.L.Unlock()
+	kss.producer.Input() <- msg
+}
+
+// delivered records the delivery of a message, err being the delivery error if it failed
+func (kss *StreamingService) delivered(err error) {
+	kss.pendingCond.L.Lock()
+	kss.pending--
+	if err != nil && kss.err == nil {
+		kss.err = err
+	}
+	kss.pendingCond.L.Unlock()
+	kss.pendingCond.Broadcast()
+}
+
+// setRunning records whether the deliveries are tracked and wakes up the waits for deliveries
+func (kss *StreamingService) setRunning(running bool) {
+	kss.pendingCond.L.Lock()
+	kss.running = running
+	kss.pendingCond.L.Unlock()
+	kss.pendingCond.Broadcast()
+}
+
+func (kss *StreamingService) isRunning() bool {
+	kss.pendingCond.L.Lock()
+	defer kss.pendingCond.L.Unlock()
+	return kss.running
+}
+
+// waitDeliveries waits for the delivery of all the produced messages and returns the first delivery error
+// since the last call, if any. It returns ErrNotRunning without waiting if the deliveries are not tracked
+func (kss *StreamingService) waitDeliveries() error {
+	kss.pendingCond.L.Lock()
+	defer kss.pendingCond.L.Unlock()
+	for kss.pending > 0 && kss.running {
+		kss.pendingCond.Wait()
+	}
+	if kss.pending > 0 {
+		return ErrNotRunning
+	}
+	err := kss.err
+	kss.err = nil
+	return err
+}
+
+func blockHeightHeader(height int64) sarama.RecordHeader {
+	return sarama.RecordHeader{Key: []byte(BlockHeightHeader), Value: []byte(strconv.FormatInt(height, 10))}
+}
+
+func topicName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return fmt.Sprintf("%s-%s", prefix, name)
+}
@@ -0,0 +1,212 @@
+package kafka
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+
+	// mock store keys
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+	mockStoreKey3 = sdk.NewKVStoreKey("mockStore3")
+
+	testTopics = []string{
+		"test-begin-block", "test-deliver-tx", "test-end-block", "test-commit",
+		"test-state-mockStore1", "test-store2",
+	}
+)
+
+// recordingInterceptor records the messages sent by the producer.
+type recordingInterceptor struct {
+	mtx  sync.Mutex
+	msgs []*sarama.ProducerMessage
+}
+
+func (ri *recordingInterceptor) OnSend(msg *sarama.ProducerMessage) {
+	ri.mtx.Lock()
+	defer ri.mtx.Unlock()
+	ri.msgs = append(ri.msgs, msg)
+}
+
+func (ri *recordingInterceptor) messages() []*sarama.ProducerMessage {
+	ri.mtx.Lock()
+	defer ri.mtx.Unlock()
+	return append([]*sarama.ProducerMessage{}, ri.msgs...)
+}
+
+// newTestBroker starts an in-process kafka broker leading a single partition
+// of each of the test topics.
+func newTestBroker(t *testing.T) (*sarama.MockBroker, *sarama.MockProduceResponse) {
+	broker := sarama.NewMockBroker(t, 1)
+	t.Cleanup(broker.Close)
+	metadata := sarama.NewMockMetadataResponse(t).SetBroker(broker.Addr(), broker.BrokerID())
+	for _, topic := range testTopics {
+		metadata.SetLeader(topic, 0, broker.BrokerID())
+	}
+	produce := sarama.NewMockProduceResponse(t).SetVersion(3)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": metadata,
+		"ProduceRequest":  produce,
+	})
+	return broker, produce
+}
+
+func newTestService(t *testing.T, broker *sarama.MockBroker) (*StreamingService, *recordingInterceptor) {
+	interceptor := &recordingInterceptor{}
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Producer.Retry.Max = 0
+	saramaConfig.Producer.Interceptors = []sarama.ProducerInterceptor{interceptor}
+	config := Config{
+		Brokers:     []string{broker.Addr()},
+		TopicPrefix: "test",
+		Topics:      map[string]string{mockStoreKey2.Name(): "store2"},
+		BatchSize:   2,
+		Ack:         AckAll,
+		Sarama:      saramaConfig,
+	}
+	service, err := NewStreamingService(config, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, service.Stream(wg))
+	t.Cleanup(func() {
+		require.NoError(t, service.Close())
+		wg.Wait()
+	})
+	return service, interceptor
+}
+
+func header(msg *sarama.ProducerMessage, key string) string {
+	for _, h := range msg.Headers {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func TestConfigValidation(t *testing.T) {
+	_, err := NewStreamingService(Config{}, nil, testMarshaller)
+	require.Error(t, err)
+	_, err = NewStreamingService(Config{Brokers: []string{"localhost:9092"}, Ack: "some"}, nil, testMarshaller)
+	require.Error(t, err)
+	_, err = NewStreamingService(Config{Brokers: []string{"localhost:9092"}, BatchSize: -1}, nil, testMarshaller)
+	require.Error(t, err)
+}
+
+func TestStreamingService(t *testing.T) {
+	broker, _ := newTestBroker(t)
+	service, interceptor := newTestService(t, broker)
+	require.Len(t, service.Listeners(), 2)
+
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	beginBlockReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+	require.NoError(t, service.ListenBeginBlock(ctx, beginBlockReq, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx0")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx1")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: mockStoreKey2.Name(), Key: []byte("key2"), Delete: true},
+		{StoreKey: mockStoreKey3.Name(), Key: []byte("key3"), Value: []byte("value3")},
+	}
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")}, changeSet))
+
+	// all the messages have been delivered to the broker when ListenCommit returns
+	msgs := interceptor.messages()
+	require.Len(t, msgs, 7)
+	topics := make([]string, len(msgs))
+	for i, msg := range msgs {
+		topics[i] = msg.Topic
+		require.Equal(t, "1", header(msg, BlockHeightHeader))
+	}
+	require.Equal(t, []string{
+		"test-begin-block", "test-deliver-tx", "test-deliver-tx", "test-end-block",
+		"test-state-mockStore1", "test-store2", "test-commit",
+	}, topics)
+	require.NotEmpty(t, broker.History())
+
+	// the ABCI messages are keyed by block height
+	key, err := msgs[0].Key.Encode()
+	require.NoError(t, err)
+	require.Equal(t, "1", string(key))
+	var beginBlock plugin.ListenBeginBlockRequest
+	value, err := msgs[0].Value.Encode()
+	require.NoError(t, err)
+	require.NoError(t, testMarshaller.Unmarshal(value, &beginBlock))
+	require.Equal(t, beginBlockReq, beginBlock.Req)
+
+	require.Equal(t, "0", header(msgs[1], TxIndexHeader))
+	require.Equal(t, "1", header(msgs[2], TxIndexHeader))
+	var deliverTx plugin.ListenDeliverTxRequest
+	value, err = msgs[2].Value.Encode()
+	require.NoError(t, err)
+	require.NoError(t, testMarshaller.Unmarshal(value, &deliverTx))
+	require.Equal(t, []byte("tx1"), deliverTx.Req.Tx)
+
+	// the state changes are keyed by KVStore key
+	for i, pair := range changeSet[:2] {
+		key, err := msgs[4+i].Key.Encode()
+		require.NoError(t, err)
+		require.Equal(t, pair.Key, key)
+		var decoded types.StoreKVPair
+		value, err := msgs[4+i].Value.Encode()
+		require.NoError(t, err)
+		require.NoError(t, testMarshaller.Unmarshal(value, &decoded))
+		require.Equal(t, *pair, decoded)
+	}
+
+	var commit plugin.ListenCommitRequest
+	value, err = msgs[6].Value.Encode()
+	require.NoError(t, err)
+	require.NoError(t, testMarshaller.Unmarshal(value, &commit))
+	require.Equal(t, int64(1), commit.BlockHeight)
+	require.Equal(t, []byte("hash"), commit.Res.Data)
+}
+
+func TestStreamingServiceDeliveryError(t *testing.T) {
+	broker, produce := newTestBroker(t)
+	produce.SetError("test-state-mockStore1", 0, sarama.ErrNotEnoughReplicas)
+	service, interceptor := newTestService(t, broker)
+
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")},
+	}
+	err := service.ListenCommit(ctx, abci.ResponseCommit{}, changeSet)
+	require.ErrorIs(t, err, sarama.ErrNotEnoughReplicas)
+
+	// the block is not marked as committed
+	for _, msg := range interceptor.messages() {
+		require.NotEqual(t, "test-commit", msg.Topic)
+	}
+}
+
+func TestStreamingServiceNotRunning(t *testing.T) {
+	broker, _ := newTestBroker(t)
+	config := Config{Brokers: []string{broker.Addr()}, TopicPrefix: "test"}
+	service, err := NewStreamingService(config, []types.StoreKey{mockStoreKey1}, testMarshaller)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, service.Close()) })
+
+	// nothing is produced, and the deliveries are not tracked, before Stream is called
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	require.ErrorIs(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}), ErrNotRunning)
+	require.ErrorIs(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}), ErrNotRunning)
+	require.ErrorIs(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}), ErrNotRunning)
+	require.ErrorIs(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil), ErrNotRunning)
+}
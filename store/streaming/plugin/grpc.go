@@ -0,0 +1,73 @@
+package plugin
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	_ Listener                  = &grpcClient{}
+	_ ABCIListenerServiceServer = &grpcServer{}
+)
+
+// grpcClient is the node side Listener forwarding the calls to the plugin.
+type grpcClient struct {
+	client ABCIListenerServiceClient
+}
+
+func (c *grpcClient) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock, changeSet []*types.StoreKVPair) error {
+	_, err := c.client.ListenBeginBlock(ctx, &ListenBeginBlockRequest{Req: req, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+func (c *grpcClient) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock, changeSet []*types.StoreKVPair) error {
+	_, err := c.client.ListenEndBlock(ctx, &ListenEndBlockRequest{Req: req, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+func (c *grpcClient) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, changeSet []*types.StoreKVPair) error {
+	_, err := c.client.ListenDeliverTx(ctx, &ListenDeliverTxRequest{Req: req, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+func (c *grpcClient) ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	_, err := c.client.ListenCommit(ctx, &ListenCommitRequest{BlockHeight: blockHeight, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+// grpcServer is the plugin side gRPC server calling the Listener
+// implementation.
+type grpcServer struct {
+	impl Listener
+}
+
+func (s *grpcServer) ListenBeginBlock(ctx context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	if err := s.impl.ListenBeginBlock(ctx, req.Req, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenBeginBlockResponse{}, nil
+}
+
+func (s *grpcServer) ListenEndBlock(ctx context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	if err := s.impl.ListenEndBlock(ctx, req.Req, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenEndBlockResponse{}, nil
+}
+
+func (s *grpcServer) ListenDeliverTx(ctx context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	if err := s.impl.ListenDeliverTx(ctx, req.Req, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenDeliverTxResponse{}, nil
+}
+
+func (s *grpcServer) ListenCommit(ctx context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	if err := s.impl.ListenCommit(ctx, req.BlockHeight, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenCommitResponse{}, nil
+}
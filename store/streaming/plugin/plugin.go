@@ -0,0 +1,79 @@
+package plugin
+
+import (
+	"context"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PluginName is the name under which the ABCI listener is dispensed by the
+// plugin process.
+const PluginName = "abci_listener"
+
+// Handshake is the handshake configuration shared by the node and the plugin
+// processes. It is a UX feature rather than a security one: it prevents
+// running arbitrary binaries as streaming plugins.
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "COSMOS_SDK_STREAMING_PLUGIN",
+	MagicCookieValue: "abci_listener",
+}
+
+// Listener is the interface implemented by out-of-process streaming plugins.
+// The methods are called in the order the node processed the ABCI messages,
+// with the state changes resulting from each of them.
+type Listener interface {
+	// ListenBeginBlock receives a BeginBlock request, its response and the
+	// resulting state changes.
+	ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock, changeSet []*types.StoreKVPair) error
+	// ListenEndBlock receives an EndBlock request, its response and the
+	// resulting state changes.
+	ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock, changeSet []*types.StoreKVPair) error
+	// ListenDeliverTx receives a DeliverTx request, its response and the
+	// resulting state changes.
+	ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, changeSet []*types.StoreKVPair) error
+	// ListenCommit receives the Commit response of the block at the given
+	// height and all the state changes committed in the block, ordered by store
+	// key and key. Returning from it acknowledges the block.
+	ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error
+}
+
+var _ goplugin.GRPCPlugin = &GRPCPlugin{}
+
+// GRPCPlugin is the go-plugin implementation of a Listener served over gRPC.
+type GRPCPlugin struct {
+	goplugin.NetRPCUnsupportedPlugin
+
+	// Impl is the Listener served by the plugin process. It is only required
+	// on the plugin side.
+	Impl Listener
+}
+
+// GRPCServer registers the Listener implementation on the plugin's gRPC
+// server.
+func (p *GRPCPlugin) GRPCServer(_ *goplugin.GRPCBroker, s *grpc.Server) error {
+	RegisterABCIListenerServiceServer(s, &grpcServer{impl: p.Impl})
+	return nil
+}
+
+// GRPCClient returns a Listener forwarding the calls to the plugin process.
+func (p *GRPCPlugin) GRPCClient(_ context.Context, _ *goplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &grpcClient{client: NewABCIListenerServiceClient(c)}, nil
+}
+
+// Serve serves the provided Listener as a streaming plugin. It is meant to be
+// called from the main function of the plugin binary and blocks until the
+// node closes the plugin.
+func Serve(impl Listener) {
+	goplugin.Serve(&goplugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: map[string]goplugin.Plugin{
+			PluginName: &GRPCPlugin{Impl: impl},
+		},
+		GRPCServer: goplugin.DefaultGRPCServer,
+	})
+}
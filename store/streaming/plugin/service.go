@@ -0,0 +1,275 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// DefaultBufferSize is the default number of ABCI messages buffered before
+// the node blocks waiting for the plugin.
+const DefaultBufferSize = 1000
+
+// StreamingService is a concrete implementation of StreamingService that
+// forwards the ABCI messages and the resulting state changes to a plugin
+// running in a separate process.
+//
+// The messages are delivered asynchronously: the node only blocks when the
+// buffer is full or, if haltOnLag is positive, when the plugin has not
+// acknowledged the block committed haltOnLag blocks ago.
+type StreamingService struct {
+	listeners      map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	client         *goplugin.Client                         // the plugin process client, nil if the listener runs in-process
+	listener       Listener                                 // the listener the messages are forwarded to
+	stateCache     []*types.StoreKVPair                     // cache the StoreKVPairs in the order they are received
+	stateCacheLock *sync.Mutex                              // mutex for the state cache
+	msgs           chan func(context.Context) error         // the messages waiting to be sent to the plugin
+	haltOnLag      int64                                    // the number of unacknowledged blocks after which Commit blocks, 0 to disable
+	ackCond        *sync.Cond                               // signals updates of ackHeight and err
+	ackHeight      int64                                    // the last block height acknowledged by the plugin
+	err            error                                    // the first error returned by the plugin
+	quitChan       chan struct{}                            // channel to synchronize closure
+}
+
+// NewStreamingService starts the plugin binary at pluginPath and creates a
+// new StreamingService forwarding the state changes of the provided storeKeys
+// to it.
+func NewStreamingService(pluginPath string, bufferSize int, haltOnLag int64, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if pluginPath == "" {
+		return nil, errors.New("plugin path cannot be empty")
+	}
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          map[string]goplugin.Plugin{PluginName: &GRPCPlugin{}},
+		Cmd:              exec.Command(pluginPath), //nolint:gosec
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolGRPC},
+	})
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("failed to start streaming plugin %s: %w", pluginPath, err)
+	}
+	raw, err := rpcClient.Dispense(PluginName)
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("failed to dispense streaming plugin %s: %w", pluginPath, err)
+	}
+	listener, ok := raw.(Listener)
+	if !ok {
+		client.Kill()
+		return nil, fmt.Errorf("streaming plugin %s does not implement the ABCI listener", pluginPath)
+	}
+	service, err := NewListenerStreamingService(listener, bufferSize, haltOnLag, storeKeys)
+	if err != nil {
+		client.Kill()
+		return nil, err
+	}
+	service.client = client
+	return service, nil
+}
+
+// NewListenerStreamingService creates a new StreamingService forwarding the
+// state changes of the provided storeKeys to an already dispensed Listener.
+func NewListenerStreamingService(listener Listener, bufferSize int, haltOnLag int64, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if bufferSize < 0 {
+		return nil, fmt.Errorf("buffer size cannot be negative: %d", bufferSize)
+	}
+	if haltOnLag < 0 {
+		return nil, fmt.Errorf("halt on lag cannot be negative: %d", haltOnLag)
+	}
+	if bufferSize == 0 {
+		bufferSize = DefaultBufferSize
+	}
+	pss := &StreamingService{
+		listener:       listener,
+		stateCacheLock: new(sync.Mutex),
+		msgs:           make(chan func(context.Context) error, bufferSize),
+		haltOnLag:      haltOnLag,
+		ackCond:        sync.NewCond(new(sync.Mutex)),
+	}
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		listeners[key] = append(listeners[key], pss)
+	}
+	pss.listeners = listeners
+	return pss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (pss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return pss.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface by caching the
+// StoreKVPair until the next ABCI message is received
+func (pss *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	pss.stateCacheLock.Lock()
+	pss.stateCache = append(pss.stateCache, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	pss.stateCacheLock.Unlock()
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It forwards the received BeginBlock request and response and the resulting
+// state changes to the plugin
+func (pss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	changeSet := pss.popStateCache()
+	return pss.enqueue(func(ctx context.Context) error {
+		return pss.listener.ListenBeginBlock(ctx, req, res, changeSet)
+	})
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It forwards the received DeliverTx request and response and the resulting
+// state changes to the plugin
+func (pss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	changeSet := pss.popStateCache()
+	return pss.enqueue(func(ctx context.Context) error {
+		return pss.listener.ListenDeliverTx(ctx, req, res, changeSet)
+	})
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It forwards the received EndBlock request and response and the resulting
+// state changes to the plugin
+func (pss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	changeSet := pss.popStateCache()
+	return pss.enqueue(func(ctx context.Context) error {
+		return pss.listener.ListenEndBlock(ctx, req, res, changeSet)
+	})
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It forwards the Commit response and the state changes committed in the block
+// to the plugin and, if haltOnLag is set, blocks until the plugin is less than
+// haltOnLag blocks behind
+func (pss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	// the state changes cached since EndBlock are the ones written when
+	// committing the block, which are already part of the change set
+	pss.popStateCache()
+	height := ctx.BlockHeight()
+	if err := pss.enqueue(func(ctx context.Context) error {
+		if err := pss.listener.ListenCommit(ctx, height, res, changeSet); err != nil {
+			return err
+		}
+		pss.ackCond.L.Lock()
+		pss.ackHeight = height
+		pss.ackCond.L.Unlock()
+		pss.ackCond.Broadcast()
+		return nil
+	}); err != nil {
+		return err
+	}
+	if pss.haltOnLag == 0 {
+		return nil
+	}
+	pss.ackCond.L.Lock()
+	defer pss.ackCond.L.Unlock()
+	for pss.err == nil && height-pss.ackHeight >= pss.haltOnLag {
+		pss.ackCond.Wait()
+	}
+	return pss.err
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It spins up a goroutine which sends the buffered messages to the plugin in
+// the order they were received
+// returns an error if it is called twice
+func (pss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if pss.quitChan != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	pss.quitChan = make(chan struct{})
+	quitChan := pss.quitChan
+	ctx, cancel := context.WithCancel(context.Background())
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		for {
+			select {
+			case <-quitChan:
+				return
+			case msg := <-pss.msgs:
+				if pss.Err() != nil {
+					// drop the messages once the plugin has failed
+					continue
+				}
+				if err := msg(ctx); err != nil {
+					pss.fail(err)
+				}
+			}
+		}
+	}()
+	go func() {
+		<-quitChan
+		cancel()
+	}()
+	return nil
+}
+
+// Err returns the first error returned by the plugin, if any. Once the plugin
+// has failed, no more messages are sent to it.
+func (pss *StreamingService) Err() error {
+	pss.ackCond.L.Lock()
+	defer pss.ackCond.L.Unlock()
+	return pss.err
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It stops the streaming loop and kills the plugin process
+func (pss *StreamingService) Close() error {
+	if pss.quitChan != nil {
+		close(pss.quitChan)
+		pss.quitChan = nil
+	}
+	pss.fail(errors.New("streaming service closed"))
+	if pss.client != nil {
+		pss.client.Kill()
+	}
+	return nil
+}
+
+func (pss *StreamingService) enqueue(msg func(context.Context) error) error {
+	if err := pss.Err(); err != nil {
+		return fmt.Errorf("streaming plugin failed: %w", err)
+	}
+	pss.msgs <- msg
+	return nil
+}
+
+func (pss *StreamingService) fail(err error) {
+	pss.ackCond.L.Lock()
+	if pss.err == nil {
+		pss.err = err
+	}
+	pss.ackCond.L.Unlock()
+	pss.ackCond.Broadcast()
+}
+
+func (pss *StreamingService) popStateCache() []*types.StoreKVPair {
+	pss.stateCacheLock.Lock()
+	defer pss.stateCacheLock.Unlock()
+	changeSet := pss.stateCache
+	pss.stateCache = nil
+	return changeSet
+}
@@ -0,0 +1,167 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+)
+
+// recordingListener is a Listener recording the received messages.
+type recordingListener struct {
+	mtx       sync.Mutex
+	msgs      []string
+	changeSet []*types.StoreKVPair
+	commits   chan int64
+	release   chan struct{}
+	err       error
+}
+
+func (l *recordingListener) record(msg string, changeSet []*types.StoreKVPair) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.msgs = append(l.msgs, msg)
+	l.changeSet = append(l.changeSet, changeSet...)
+	return l.err
+}
+
+func (l *recordingListener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock, changeSet []*types.StoreKVPair) error {
+	return l.record("begin", changeSet)
+}
+
+func (l *recordingListener) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock, changeSet []*types.StoreKVPair) error {
+	return l.record("end", changeSet)
+}
+
+func (l *recordingListener) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, _ abci.ResponseDeliverTx, changeSet []*types.StoreKVPair) error {
+	return l.record("tx:"+string(req.Tx), changeSet)
+}
+
+func (l *recordingListener) ListenCommit(_ context.Context, blockHeight int64, _ abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	if l.release != nil {
+		<-l.release
+	}
+	if err := l.record("commit", changeSet); err != nil {
+		return err
+	}
+	l.commits <- blockHeight
+	return nil
+}
+
+func (l *recordingListener) messages() []string {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return append([]string{}, l.msgs...)
+}
+
+// newTestService dispenses the provided listener through an in-memory gRPC
+// plugin connection and wraps it in a StreamingService.
+func newTestService(t *testing.T, impl Listener, haltOnLag int64) *StreamingService {
+	client, _ := goplugin.TestPluginGRPCConn(t, map[string]goplugin.Plugin{
+		PluginName: &GRPCPlugin{Impl: impl},
+	})
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense(PluginName)
+	require.NoError(t, err)
+	service, err := NewListenerStreamingService(raw.(Listener), 10, haltOnLag, []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+	require.NoError(t, service.Stream(new(sync.WaitGroup)))
+	t.Cleanup(func() { service.Close() })
+	return service
+}
+
+func TestNewListenerStreamingService(t *testing.T) {
+	_, err := NewListenerStreamingService(&recordingListener{}, -1, 0, nil)
+	require.Error(t, err)
+	_, err = NewListenerStreamingService(&recordingListener{}, 0, -1, nil)
+	require.Error(t, err)
+
+	service, err := NewListenerStreamingService(&recordingListener{}, 0, 0, []types.StoreKey{mockStoreKey1})
+	require.NoError(t, err)
+	require.Equal(t, DefaultBufferSize, cap(service.msgs))
+	require.Len(t, service.Listeners()[mockStoreKey1], 1)
+
+	_, err = NewStreamingService("", 0, 0, nil)
+	require.Error(t, err)
+}
+
+func TestStreamingServiceForwardsMessages(t *testing.T) {
+	impl := &recordingListener{commits: make(chan int64, 1)}
+	service := newTestService(t, impl, 0)
+	ctx := sdk.Context{}.WithBlockHeight(1)
+
+	listener := service.Listeners()[mockStoreKey1][0]
+	require.NoError(t, listener.OnWrite(mockStoreKey1, []byte("key1"), []byte("value1"), false))
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, listener.OnWrite(mockStoreKey2, []byte("key2"), nil, true))
+	require.NoError(t, service.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx1")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+	// the state changes written when committing are only forwarded as part of the change set
+	require.NoError(t, listener.OnWrite(mockStoreKey1, []byte("key1"), []byte("value1"), false))
+	committed := &types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")}
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, []*types.StoreKVPair{committed}))
+
+	select {
+	case height := <-impl.commits:
+		require.Equal(t, int64(1), height)
+	case <-time.After(5 * time.Second):
+		t.Fatal("commit not received by the plugin")
+	}
+	require.Equal(t, []string{"begin", "tx:tx1", "end", "commit"}, impl.messages())
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: mockStoreKey2.Name(), Delete: true, Key: []byte("key2")},
+		committed,
+	}, impl.changeSet)
+}
+
+func TestStreamingServiceHaltOnLag(t *testing.T) {
+	impl := &recordingListener{commits: make(chan int64, 1), release: make(chan struct{})}
+	service := newTestService(t, impl, 1)
+
+	done := make(chan error)
+	go func() {
+		done <- service.ListenCommit(sdk.Context{}.WithBlockHeight(1), abci.ResponseCommit{}, nil)
+	}()
+
+	// the commit blocks until the plugin acknowledges the block
+	select {
+	case <-done:
+		t.Fatal("commit returned before the plugin acknowledged the block")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(impl.release)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("commit still blocked after the plugin acknowledged the block")
+	}
+}
+
+func TestStreamingServicePluginError(t *testing.T) {
+	impl := &recordingListener{commits: make(chan int64, 1), err: errors.New("plugin failure")}
+	service := newTestService(t, impl, 1)
+	ctx := sdk.Context{}.WithBlockHeight(1)
+
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	// the failure is reported by the commit waiting for the plugin
+	require.Error(t, service.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	require.Error(t, service.Err())
+	require.Error(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+}
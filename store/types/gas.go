@@ -218,37 +218,105 @@ func (g *infiniteGasMeter) String() string {
 
 // GasConfig defines gas cost for each operation on KVStores
 type GasConfig struct {
-	HasCost          Gas
-	DeleteCost       Gas
-	ReadCostFlat     Gas
-	ReadCostPerByte  Gas
-	WriteCostFlat    Gas
-	WriteCostPerByte Gas
-	IterNextCostFlat Gas
+	HasCost             Gas `json:"has_cost" yaml:"has_cost"`
+	DeleteCost          Gas `json:"delete_cost" yaml:"delete_cost"`
+	ReadCostFlat        Gas `json:"read_cost_flat" yaml:"read_cost_flat"`
+	ReadCostPerByte     Gas `json:"read_cost_per_byte" yaml:"read_cost_per_byte"`
+	WriteCostFlat       Gas `json:"write_cost_flat" yaml:"write_cost_flat"`
+	WriteCostPerByte    Gas `json:"write_cost_per_byte" yaml:"write_cost_per_byte"`
+	IterNextCostFlat    Gas `json:"iter_next_cost_flat" yaml:"iter_next_cost_flat"`
+	IterNextCostPerByte Gas `json:"iter_next_cost_per_byte" yaml:"iter_next_cost_per_byte"`
 }
 
 // KVGasConfig returns a default gas config for KVStores.
 func KVGasConfig() GasConfig {
 	return GasConfig{
-		HasCost:          1000,
-		DeleteCost:       1000,
-		ReadCostFlat:     1000,
-		ReadCostPerByte:  3,
-		WriteCostFlat:    2000,
-		WriteCostPerByte: 30,
-		IterNextCostFlat: 30,
+		HasCost:             1000,
+		DeleteCost:          1000,
+		ReadCostFlat:        1000,
+		ReadCostPerByte:     3,
+		WriteCostFlat:       2000,
+		WriteCostPerByte:    30,
+		IterNextCostFlat:    30,
+		IterNextCostPerByte: 3,
 	}
 }
 
 // TransientGasConfig returns a default gas config for TransientStores.
 func TransientGasConfig() GasConfig {
 	return GasConfig{
-		HasCost:          100,
-		DeleteCost:       100,
-		ReadCostFlat:     100,
-		ReadCostPerByte:  0,
-		WriteCostFlat:    200,
-		WriteCostPerByte: 3,
-		IterNextCostFlat: 3,
+		HasCost:             100,
+		DeleteCost:          100,
+		ReadCostFlat:        100,
+		ReadCostPerByte:     0,
+		WriteCostFlat:       200,
+		WriteCostPerByte:    3,
+		IterNextCostFlat:    3,
+		IterNextCostPerByte: 0,
 	}
 }
+
+// StoreGasConfig defines the gas config of the store with a store key name.
+type StoreGasConfig struct {
+	StoreKey  string    `json:"store_key" yaml:"store_key"`
+	GasConfig GasConfig `json:"gas_config" yaml:"gas_config"`
+}
+
+// GasSchedule defines the gas configs of stores by store key name. Stores without
+// a gas config are charged with the default gas config for their type.
+type GasSchedule struct {
+	Stores []StoreGasConfig `json:"stores" yaml:"stores"`
+}
+
+// GasConfig returns the gas config of the store with a key, or the given default
+// gas config if it has none.
+func (gs GasSchedule) GasConfig(key StoreKey, defaultConfig GasConfig) GasConfig {
+	name := key.Name()
+	for _, store := range gs.Stores {
+		if store.StoreKey == name {
+			return store.GasConfig
+		}
+	}
+
+	return defaultConfig
+}
+
+// Override returns the gas schedule with the gas configs of another gas schedule,
+// which replace those for the same stores.
+func (gs GasSchedule) Override(other GasSchedule) GasSchedule {
+	stores := make([]StoreGasConfig, 0, len(gs.Stores)+len(other.Stores))
+	for _, store := range gs.Stores {
+		if !other.hasStore(store.StoreKey) {
+			stores = append(stores, store)
+		}
+	}
+
+	return GasSchedule{Stores: append(stores, other.Stores...)}
+}
+
+func (gs GasSchedule) hasStore(name string) bool {
+	for _, store := range gs.Stores {
+		if store.StoreKey == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns an error if a gas config has no store key, or a store has more
+// than one gas config.
+func (gs GasSchedule) Validate() error {
+	seen := make(map[string]bool, len(gs.Stores))
+	for _, store := range gs.Stores {
+		if store.StoreKey == "" {
+			return fmt.Errorf("gas config has an empty store key")
+		}
+		if seen[store.StoreKey] {
+			return fmt.Errorf("duplicate gas config for store %s", store.StoreKey)
+		}
+		seen[store.StoreKey] = true
+	}
+
+	return nil
+}
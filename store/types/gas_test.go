@@ -112,12 +112,36 @@ func TestTransientGasConfig(t *testing.T) {
 	t.Parallel()
 	config := TransientGasConfig()
 	require.Equal(t, config, GasConfig{
-		HasCost:          100,
-		DeleteCost:       100,
-		ReadCostFlat:     100,
-		ReadCostPerByte:  0,
-		WriteCostFlat:    200,
-		WriteCostPerByte: 3,
-		IterNextCostFlat: 3,
+		HasCost:             100,
+		DeleteCost:          100,
+		ReadCostFlat:        100,
+		ReadCostPerByte:     0,
+		WriteCostFlat:       200,
+		WriteCostPerByte:    3,
+		IterNextCostFlat:    3,
+		IterNextCostPerByte: 0,
 	})
 }
+
+func TestGasSchedule(t *testing.T) {
+	t.Parallel()
+	cheap := GasConfig{HasCost: 1, ReadCostFlat: 1}
+	schedule := GasSchedule{Stores: []StoreGasConfig{
+		{StoreKey: "bank", GasConfig: cheap},
+		{StoreKey: "acc", GasConfig: TransientGasConfig()},
+	}}
+	require.NoError(t, schedule.Validate())
+
+	require.Equal(t, cheap, schedule.GasConfig(NewKVStoreKey("bank"), KVGasConfig()))
+	require.Equal(t, KVGasConfig(), schedule.GasConfig(NewKVStoreKey("staking"), KVGasConfig()))
+	require.Equal(t, TransientGasConfig(), GasSchedule{}.GasConfig(NewTransientStoreKey("bank"), TransientGasConfig()))
+
+	override := schedule.Override(GasSchedule{Stores: []StoreGasConfig{{StoreKey: "acc", GasConfig: cheap}}})
+	require.NoError(t, override.Validate())
+	require.Equal(t, cheap, override.GasConfig(NewKVStoreKey("acc"), KVGasConfig()))
+	require.Equal(t, cheap, override.GasConfig(NewKVStoreKey("bank"), KVGasConfig()))
+	require.Len(t, schedule.Stores, 2)
+
+	require.Error(t, GasSchedule{Stores: []StoreGasConfig{{GasConfig: cheap}}}.Validate())
+	require.Error(t, GasSchedule{Stores: []StoreGasConfig{{StoreKey: "bank"}, {StoreKey: "bank"}}}.Validate())
+}
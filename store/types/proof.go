@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	ics23 "github.com/confio/ics23/go"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmmerkle "github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -11,6 +13,7 @@ import (
 const (
 	ProofOpIAVLCommitment         = "ics23:iavl"
 	ProofOpSimpleMerkleCommitment = "ics23:simple"
+	ProofOpSMTCommitment          = "ics23:smt"
 )
 
 // CommitmentOp implements merkle.ProofOperator by wrapping an ics23 CommitmentProof
@@ -46,6 +49,17 @@ func NewSimpleMerkleCommitmentOp(key []byte, proof *ics23.CommitmentProof) Commi
 	}
 }
 
+// NewSmtCommitmentOp returns a CommitmentOp for a proof from a sparse Merkle tree. The tree orders its
+// leaves by the SHA-256 hash of their keys, so the embedded proof is keyed by the hash of key.
+func NewSmtCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpSMTCommitment,
+		Spec:  ics23.SmtSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
 // CommitmentOpDecoder takes a merkle.ProofOp and attempts to decode it into a CommitmentOp ProofOperator
 // The proofOp.Data is just a marshalled CommitmentProof. The Key of the CommitmentOp is extracted
 // from the unmarshalled proof.
@@ -56,8 +70,10 @@ func CommitmentOpDecoder(pop tmmerkle.ProofOp) (merkle.ProofOperator, error) {
 		spec = ics23.IavlSpec
 	case ProofOpSimpleMerkleCommitment:
 		spec = ics23.TendermintSpec
+	case ProofOpSMTCommitment:
+		spec = ics23.SmtSpec
 	default:
-		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment' or 'ProofOpSimpleMerkleCommitment'", pop.Type)
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment', 'ProofOpSimpleMerkleCommitment' or 'ProofOpSMTCommitment'", pop.Type)
 	}
 
 	proof := &ics23.CommitmentProof{}
@@ -79,6 +95,15 @@ func (op CommitmentOp) GetKey() []byte {
 	return op.Key
 }
 
+// Returns the key as it is committed to by the embedded CommitmentProof.
+func (op CommitmentOp) provenKey() []byte {
+	if op.Type == ProofOpSMTCommitment {
+		path := sha256.Sum256(op.Key)
+		return path[:]
+	}
+	return op.Key
+}
+
 // Run takes in a list of arguments and attempts to run the proof op against these arguments
 // Returns the root wrapped in [][]byte if the proof op succeeds with given args. If not,
 // it will return an error.
@@ -98,14 +123,14 @@ func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
 	switch len(args) {
 	case 0:
 		// Args are nil, so we verify the absence of the key.
-		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key)
+		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.provenKey())
 		if !absent {
 			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify absence of key: %s", string(op.Key))
 		}
 
 	case 1:
 		// Args is length 1, verify existence of key with value args[0]
-		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
+		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.provenKey(), args[0]) {
 			return nil, sdkerrors.Wrapf(ErrInvalidProof, "proof did not verify existence of key %s with given value %x", op.Key, args[0])
 		}
 	default:
@@ -186,6 +186,17 @@ type CommitMultiStore interface {
 	SetIAVLCacheSize(size int)
 }
 
+// QueryMultiStore is the part of a multistore needed to serve queries against committed
+// versions. It is implemented by any CommitMultiStore, and by stores providing read-only access
+// to the state committed by another process.
+type QueryMultiStore interface {
+	// LastCommitID returns the ID of the latest committed version.
+	LastCommitID() CommitID
+
+	// CacheMultiStoreWithVersion branches a committed version (height).
+	CacheMultiStoreWithVersion(version int64) (CacheMultiStore, error)
+}
+
 //---------subsp-------------------------------
 // KVStore
 
@@ -0,0 +1,183 @@
+package root
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	util "github.com/cosmos/cosmos-sdk/internal"
+	v1 "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+)
+
+var (
+	_ types.Queryable       = (*ReadOnlyStore)(nil)
+	_ v1.QueryMultiStore    = (*ReadOnlyStore)(nil)
+	_ v1.CacheMultiStore    = (*versionCacheStore)(nil)
+	_ types.BasicMultiStore = (*versionCacheStore)(nil)
+)
+
+// ReadOnlyStore provides read-only access to the saved versions of a MultiStore, so that queries
+// can be served from a separate handle to a DB that is written by another Store, e.g. in another
+// process. It never writes to or reverts the backing DBs, and follows the versions saved by the
+// writer: the latest version and the versions available for queries are read from the DB on
+// every access. Each version is read with the schema saved along with it.
+type ReadOnlyStore struct {
+	stateDB           dbm.DBConnection
+	StateCommitmentDB dbm.DBConnection
+}
+
+// Branched state of a saved version, implementing the v1 CacheMultiStore interface so that it can
+// back an SDK query context. Since the version is read-only, writes can only be made to branches
+// of it and cannot be written back to the version itself.
+type versionCacheStore struct {
+	root *ReadOnlyStore
+	*cacheStore
+}
+
+// NewReadOnlyStore constructs a ReadOnlyStore from a database. Only the StateCommitmentDB option
+// is used, which must be the same as that of the Store writing to db.
+func NewReadOnlyStore(db dbm.DBConnection, opts StoreConfig) (*ReadOnlyStore, error) {
+	ret := &ReadOnlyStore{
+		stateDB:           db,
+		StateCommitmentDB: opts.StateCommitmentDB,
+	}
+	// Check that the saved schema can be read, so that a DB which is not a MultiStore fails early
+	last, err := ret.lastVersion()
+	if err != nil {
+		return nil, err
+	}
+	if last != 0 {
+		view, err := ret.getView(int64(last))
+		if err != nil {
+			return nil, err
+		}
+		if err = view.discard(); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// Returns the latest version saved to all backing DBs. Since the state commitment DB is saved
+// after the state DB, it may lag behind by a version while the writer is committing.
+func (rs *ReadOnlyStore) lastVersion() (uint64, error) {
+	versions, err := rs.stateDB.Versions()
+	if err != nil {
+		return 0, err
+	}
+	last := versions.Last()
+	if rs.StateCommitmentDB == nil || last == 0 {
+		return last, nil
+	}
+	scVersions, err := rs.StateCommitmentDB.Versions()
+	if err != nil {
+		return 0, err
+	}
+	if !scVersions.Exists(last) && versions.Exists(last-1) {
+		last--
+	}
+	return last, nil
+}
+
+func (rs *ReadOnlyStore) getView(version int64) (*viewStore, error) {
+	return openView(rs.stateDB, rs.StateCommitmentDB, version)
+}
+
+// LastCommitID returns the ID of the latest saved version.
+func (rs *ReadOnlyStore) LastCommitID() types.CommitID {
+	last, err := rs.lastVersion()
+	if err != nil {
+		panic(err)
+	}
+	if last == 0 {
+		return types.CommitID{}
+	}
+	view, err := rs.getView(int64(last))
+	if err != nil {
+		panic(err)
+	}
+	hash, err := view.stateView.Get(merkleRootKey)
+	if err = util.CombineErrors(err, view.discard(), "view.discard also failed"); err != nil {
+		panic(err)
+	}
+	return types.CommitID{Version: int64(last), Hash: hash}
+}
+
+// GetVersion returns a read-only view of a saved version.
+func (rs *ReadOnlyStore) GetVersion(version int64) (types.BasicMultiStore, error) {
+	return rs.getView(version)
+}
+
+// CacheMultiStoreWithVersion implements v1 QueryMultiStore. It returns a branch of a saved version.
+func (rs *ReadOnlyStore) CacheMultiStoreWithVersion(version int64) (v1.CacheMultiStore, error) {
+	view, err := rs.getView(version)
+	if err != nil {
+		return nil, err
+	}
+	return rs.newVersionCacheStore(view), nil
+}
+
+// Query implements Queryable, serving queries in the same way as Store.
+func (rs *ReadOnlyStore) Query(req abci.RequestQuery) abci.ResponseQuery {
+	return queryVersion(req, rs.stateDB, rs.getView, nil)
+}
+
+func (rs *ReadOnlyStore) newVersionCacheStore(source types.BasicMultiStore) *versionCacheStore {
+	return &versionCacheStore{
+		root: rs,
+		cacheStore: &cacheStore{
+			source:           source,
+			substores:        map[string]types.CacheKVStore{},
+			traceListenMixin: newTraceListenMixin(),
+		},
+	}
+}
+
+// GetStoreType implements v1 Store.
+func (vcs *versionCacheStore) GetStoreType() v1.StoreType {
+	return v1.StoreTypeMulti
+}
+
+// GetStore implements v1 MultiStore.
+func (vcs *versionCacheStore) GetStore(skey v1.StoreKey) v1.Store {
+	return vcs.GetKVStore(skey)
+}
+
+// CacheMultiStore implements v1 MultiStore.
+func (vcs *versionCacheStore) CacheMultiStore() v1.CacheMultiStore {
+	return vcs.root.newVersionCacheStore(vcs.cacheStore)
+}
+
+// CacheMultiStoreWithVersion implements v1 MultiStore.
+func (vcs *versionCacheStore) CacheMultiStoreWithVersion(version int64) (v1.CacheMultiStore, error) {
+	return vcs.root.CacheMultiStoreWithVersion(version)
+}
+
+// CacheWrap implements v1 CacheWrapper.
+func (vcs *versionCacheStore) CacheWrap() v1.CacheWrap {
+	return vcs.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements v1 CacheWrapper. Tracing is not supported.
+func (vcs *versionCacheStore) CacheWrapWithTrace(_ io.Writer, _ v1.TraceContext) v1.CacheWrap {
+	return vcs.CacheWrap()
+}
+
+// CacheWrapWithListeners implements v1 CacheWrapper. Listening is not supported.
+func (vcs *versionCacheStore) CacheWrapWithListeners(_ v1.StoreKey, _ []v1.WriteListener) v1.CacheWrap {
+	return vcs.CacheWrap()
+}
+
+// SetTracer implements v1 MultiStore.
+func (vcs *versionCacheStore) SetTracer(w io.Writer) v1.MultiStore {
+	vcs.traceListenMixin.SetTracer(w)
+	return vcs
+}
+
+// SetTracingContext implements v1 MultiStore.
+func (vcs *versionCacheStore) SetTracingContext(tc v1.TraceContext) v1.MultiStore {
+	vcs.traceListenMixin.SetTraceContext(tc)
+	return vcs
+}
@@ -0,0 +1,96 @@
+package root
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+)
+
+func TestReadOnlyStore(t *testing.T) {
+	k1, v1, v2 := []byte("k1"), []byte("v1"), []byte("v2")
+
+	db := memdb.NewDB()
+	reader, err := NewReadOnlyStore(dbWritesPanic{db}, storeConfig123(t))
+	require.NoError(t, err)
+	require.Equal(t, types.CommitID{}, reader.LastCommitID())
+
+	writer, err := NewStore(db, storeConfig123(t))
+	require.NoError(t, err)
+	writer.GetKVStore(skey_1).Set(k1, v1)
+	cid1 := writer.Commit()
+	writer.GetKVStore(skey_1).Set(k1, v2)
+	cid2 := writer.Commit()
+
+	// Uncommitted writes are not visible, and the reader follows the writer's commits
+	writer.GetKVStore(skey_1).Set(k1, []byte("uncommitted"))
+	require.Equal(t, cid2, reader.LastCommitID())
+
+	t.Run("query", func(t *testing.T) {
+		for _, tc := range []struct {
+			height int64
+			value  []byte
+		}{{cid1.Version, v1}, {cid2.Version, v2}, {0, v1}} {
+			res := reader.Query(abci.RequestQuery{Path: queryPath(skey_1, "/key"), Data: k1, Height: tc.height})
+			require.True(t, res.IsOK(), res.Log)
+			require.Equal(t, tc.value, res.Value)
+		}
+		res := reader.Query(abci.RequestQuery{Path: queryPath(skey_1, "/key"), Data: k1, Height: cid2.Version + 1})
+		require.False(t, res.IsOK())
+		res = reader.Query(abci.RequestQuery{Path: queryPath(skey_4, "/key"), Data: k1, Height: cid2.Version})
+		require.False(t, res.IsOK())
+	})
+
+	t.Run("cache multistore with version", func(t *testing.T) {
+		cms, err := reader.CacheMultiStoreWithVersion(cid1.Version)
+		require.NoError(t, err)
+		sub := cms.GetKVStore(skey_1)
+		require.Equal(t, v1, sub.Get(k1))
+
+		// Branches can be written and written back to their parent, but not to the version
+		branch := cms.CacheMultiStore()
+		branch.GetKVStore(skey_1).Set(k1, []byte("branch"))
+		require.Equal(t, v1, sub.Get(k1))
+		branch.Write()
+		require.Equal(t, []byte("branch"), cms.GetKVStore(skey_1).Get(k1))
+		require.Panics(t, func() { cms.Write() })
+
+		_, err = reader.CacheMultiStoreWithVersion(cid2.Version + 1)
+		require.Error(t, err)
+	})
+
+	// Versions deleted by the writer are no longer available
+	require.NoError(t, writer.Close())
+	require.NoError(t, db.DeleteVersion(uint64(cid1.Version)))
+	_, err = reader.GetVersion(cid1.Version)
+	require.Error(t, err)
+	require.Equal(t, cid2, reader.LastCommitID())
+}
+
+func TestReadOnlyStore_StateCommitmentDB(t *testing.T) {
+	db, scdb := memdb.NewDB(), memdb.NewDB()
+	opts := storeConfig123(t)
+	opts.StateCommitmentDB = scdb
+	writer, err := NewStore(db, opts)
+	require.NoError(t, err)
+	writer.GetKVStore(skey_1).Set([]byte("k"), []byte("v"))
+	cid1 := writer.Commit()
+	writer.GetKVStore(skey_1).Set([]byte("k"), []byte("v2"))
+	cid2 := writer.Commit()
+
+	opts.StateCommitmentDB = dbWritesPanic{scdb}
+	reader, err := NewReadOnlyStore(dbWritesPanic{db}, opts)
+	require.NoError(t, err)
+	require.Equal(t, cid2, reader.LastCommitID())
+	res := reader.Query(abci.RequestQuery{Path: queryPath(skey_1, "/key"), Data: []byte("k"), Height: cid2.Version, Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.NotNil(t, res.ProofOps)
+
+	// A version not yet saved to the state commitment DB is not used
+	require.NoError(t, writer.Close())
+	require.NoError(t, scdb.DeleteVersion(uint64(cid2.Version)))
+	require.Equal(t, cid1, reader.LastCommitID())
+}
@@ -0,0 +1,333 @@
+package root
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"io"
+	"math"
+	"sort"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	util "github.com/cosmos/cosmos-sdk/internal"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize   = uint64(10e6)
+	snapshotBufferSize  = int(snapshotChunkSize)
+	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
+)
+
+var _ snapshottypes.TrustedSnapshotter = (*Store)(nil)
+
+// SupportedFormats implements snapshottypes.Snapshotter.
+func (rs *Store) SupportedFormats() []uint32 {
+	return []uint32{snapshottypes.FormatKV}
+}
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot is a zlib-compressed stream of
+// SnapshotItem Protobuf messages split into fixed-size chunks. The first item is a SnapshotSchema
+// listing the persistent substores and the root hash at the snapshot height. It is followed by a
+// SnapshotStoreItem for each substore in order, each followed by the substore's key/value pairs
+// as SnapshotKVItems.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.FormatKV {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+	view, err := rs.getView(int64(height))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to access height %v", height)
+	}
+	rootHash, err := view.stateView.Get(merkleRootKey)
+	if err != nil {
+		return nil, util.CombineErrors(err, view.discard(), "view.discard also failed")
+	}
+	keys := view.schema.persistentKeys()
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
+		err := func() error {
+			defer view.discard()
+			bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+			zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+			if err != nil {
+				return sdkerrors.Wrap(err, "zlib failure")
+			}
+			protoWriter := protoio.NewDelimitedWriter(zWriter)
+			err = protoWriter.WriteMsg(&storetypes.SnapshotItem{
+				Item: &storetypes.SnapshotItem_Schema{
+					Schema: &storetypes.SnapshotSchema{
+						Keys:     keys,
+						RootHash: rootHash,
+					},
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, key := range keys {
+				if err = view.exportSubstore(key, protoWriter); err != nil {
+					return err
+				}
+			}
+			// Closing the delimited writer also closes and flushes the zlib writer.
+			if err = protoWriter.Close(); err != nil {
+				return err
+			}
+			return bufWriter.Flush()
+		}()
+		if err != nil {
+			chunkWriter.CloseWithError(err)
+			return
+		}
+		chunkWriter.Close()
+	}()
+
+	return ch, nil
+}
+
+// Writes a substore item followed by all key/value pairs of a substore.
+func (vs *viewStore) exportSubstore(key string, protoWriter protoio.Writer) error {
+	sub, err := vs.getSubstore(key)
+	if err != nil {
+		return err
+	}
+	err = protoWriter.WriteMsg(&storetypes.SnapshotItem{
+		Item: &storetypes.SnapshotItem_Store{
+			Store: &storetypes.SnapshotStoreItem{
+				Name: key,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	it, err := sub.dataBucket.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	for it.Next() {
+		err = protoWriter.WriteMsg(&storetypes.SnapshotItem{
+			Item: &storetypes.SnapshotItem_KV{
+				KV: &storetypes.SnapshotKVItem{
+					Key:   it.Key(),
+					Value: it.Value(),
+				},
+			},
+		})
+		if err != nil {
+			return util.CombineErrors(err, it.Close(), "it.Close also failed")
+		}
+	}
+	if err = it.Error(); err != nil {
+		return util.CombineErrors(err, it.Close(), "it.Close also failed")
+	}
+	return it.Close()
+}
+
+// Discards the view's underlying read transactions.
+func (vs *viewStore) discard() error {
+	err := vs.stateView.Discard()
+	if vs.stateCommitmentView != vs.stateView {
+		err = util.CombineErrors(err, vs.stateCommitmentView.Discard(), "stateCommitmentView.Discard also failed")
+	}
+	return err
+}
+
+// Restore implements snapshottypes.Snapshotter. The key/value pairs of each substore are written
+// to an empty store, rebuilding the SMT state commitments, and the resulting root hash is
+// verified against the snapshot's root hash before the snapshot height is committed. This only
+// guards against corrupted snapshots, use RestoreTrusted to verify untrusted snapshots.
+func (rs *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	return rs.restore(height, format, nil, chunks, ready)
+}
+
+// RestoreTrusted implements snapshottypes.TrustedSnapshotter. It restores the snapshot like
+// Restore, but verifies the resulting root hash against the trusted appHash instead of the root
+// hash recorded in the snapshot.
+func (rs *Store) RestoreTrusted(
+	height uint64, format uint32, appHash []byte, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if len(appHash) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trusted app hash is required")
+	}
+	return rs.restore(height, format, appHash, chunks, ready)
+}
+
+// restore restores a snapshot, verifying the resulting root hash against trustedHash if given,
+// and against the snapshot's root hash otherwise.
+func (rs *Store) restore(
+	height uint64, format uint32, trustedHash []byte, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) (err error) {
+	if format != snapshottypes.FormatKV {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+	versions, err := rs.stateDB.Versions()
+	if err != nil {
+		return err
+	}
+	if versions.Count() != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot into non-empty store")
+	}
+	// A failed restore must not leave any of its writes behind, or a later restore would fail.
+	defer func() {
+		if err != nil {
+			err = util.CombineErrors(err, rs.discardRestore(), "discardRestore also failed")
+		}
+	}()
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> SnapshotItem
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	// The first item must be the schema, which must match our own persistent substores.
+	item := &storetypes.SnapshotItem{}
+	if err = protoReader.ReadMsg(item); err != nil {
+		return sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+	schemaItem, ok := item.Item.(*storetypes.SnapshotItem_Schema)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected schema item, got %T", item.Item)
+	}
+	schema := schemaItem.Schema
+	if keys := rs.schema.persistentKeys(); !equalStrings(keys, schema.Keys) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot substores %v do not match store schema %v",
+			schema.Keys, keys)
+	}
+
+	var sub *substore
+	for {
+		item := &storetypes.SnapshotItem{}
+		err = protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *storetypes.SnapshotItem_Store:
+			if typ, has := rs.schema[item.Store.Name]; !has || typ != types.StoreTypePersistent {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-persistent store %q",
+					item.Store.Name)
+			}
+			sub, err = rs.getSubstore(item.Store.Name)
+			if err != nil {
+				return err
+			}
+			rs.substoreCache[item.Store.Name] = sub
+
+		case *storetypes.SnapshotItem_KV:
+			if sub == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received key/value item before store item")
+			}
+			// Protobuf does not differentiate between []byte{} and nil, but the store does not
+			// allow nil values, so we can always set them to empty.
+			if item.KV.Value == nil {
+				item.KV.Value = []byte{}
+			}
+			sub.Set(item.KV.Key, item.KV.Value)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+		}
+	}
+
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+	storeHashes, err := rs.getMerkleRoots()
+	if err != nil {
+		return err
+	}
+	rootHash := sdkmaps.HashFromMap(storeHashes)
+	if trustedHash != nil {
+		if !bytes.Equal(rootHash, trustedHash) {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "restored root hash %X does not match trusted app hash %X",
+				rootHash, trustedHash)
+		}
+	} else if !bytes.Equal(rootHash, schema.RootHash) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "restored root hash %X does not match snapshot root hash %X",
+			rootHash, schema.RootHash)
+	}
+	_, err = rs.commit(height)
+	return err
+}
+
+// discardRestore discards the writes of a failed restore by replacing the working transactions,
+// and drops the substores which were loaded from them.
+func (rs *Store) discardRestore() error {
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+	err := rs.stateTxn.Discard()
+	rs.stateTxn = rs.stateDB.ReadWriter()
+	if rs.StateCommitmentDB != nil {
+		err = util.CombineErrors(err, rs.stateCommitmentTxn.Discard(), "stateCommitmentTxn.Discard also failed")
+		rs.stateCommitmentTxn = rs.StateCommitmentDB.ReadWriter()
+	} else {
+		rs.stateCommitmentTxn = rs.stateTxn
+	}
+	rs.substoreCache = map[string]*substore{}
+	return err
+}
+
+// Returns the sorted keys of all persistent substores in the schema.
+func (ss StoreSchema) persistentKeys() []string {
+	keys := []string{}
+	for key, typ := range ss {
+		if typ == types.StoreTypePersistent {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
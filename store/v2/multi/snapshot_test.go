@@ -0,0 +1,190 @@
+package root
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+)
+
+func newStore123WithData(t *testing.T, versions int) *Store {
+	store, err := NewStore(memdb.NewDB(), storeConfig123(t))
+	require.NoError(t, err)
+	for v := 0; v < versions; v++ {
+		for i, skey := range []types.StoreKey{skey_1, skey_2, skey_3} {
+			sub := store.GetKVStore(skey)
+			for k := 0; k < 10*(i+1); k++ {
+				sub.Set([]byte(fmt.Sprintf("key%03d", k)), []byte(fmt.Sprintf("value%d-%d", v, k)))
+			}
+			sub.Delete([]byte("key000"))
+		}
+		store.Commit()
+	}
+	return store
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newStore123WithData(t, 3)
+	// Changes after the snapshot height must not be included
+	source.GetKVStore(skey_1).Set([]byte("later"), []byte("value"))
+	source.Commit()
+
+	height := uint64(3)
+	chunks, err := source.Snapshot(height, snapshottypes.FormatKV)
+	require.NoError(t, err)
+
+	target, err := NewStore(memdb.NewDB(), storeConfig123(t))
+	require.NoError(t, err)
+	ready := make(chan struct{})
+	require.NoError(t, target.Restore(height, snapshottypes.FormatKV, chunks, ready))
+	<-ready
+
+	view, err := source.getView(int64(height))
+	require.NoError(t, err)
+	defer view.discard()
+	rootHash, err := view.stateView.Get(merkleRootKey)
+	require.NoError(t, err)
+	require.Equal(t, types.CommitID{Version: int64(height), Hash: rootHash}, target.LastCommitID())
+
+	for _, skey := range []types.StoreKey{skey_1, skey_2, skey_3} {
+		expected := view.GetKVStore(skey).Iterator(nil, nil)
+		actual := target.GetKVStore(skey).Iterator(nil, nil)
+		for ; expected.Valid(); expected.Next() {
+			require.True(t, actual.Valid())
+			require.Equal(t, expected.Key(), actual.Key())
+			require.Equal(t, expected.Value(), actual.Value())
+			actual.Next()
+		}
+		require.False(t, actual.Valid())
+		require.NoError(t, expected.Close())
+		require.NoError(t, actual.Close())
+	}
+	require.False(t, target.GetKVStore(skey_1).Has([]byte("later")))
+
+	// The restored store must keep committing from the snapshot height
+	target.GetKVStore(skey_1).Set([]byte("later"), []byte("value"))
+	require.Equal(t, source.LastCommitID(), target.Commit())
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newStore123WithData(t, 2)
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.FormatKV, nil},
+		"future height":  {3, snapshottypes.FormatKV, nil},
+		"unknown format": {1, snapshottypes.FormatV1, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Snapshot(tc.height, tc.format)
+			require.Error(t, err)
+			if tc.expectType != nil {
+				require.True(t, errors.Is(err, tc.expectType))
+			}
+		})
+	}
+}
+
+func TestMultistoreRestore_Errors(t *testing.T) {
+	source := newStore123WithData(t, 1)
+
+	// restoring into a non-empty store fails
+	chunks, err := source.Snapshot(1, snapshottypes.FormatKV)
+	require.NoError(t, err)
+	require.Error(t, newStore123WithData(t, 1).Restore(1, snapshottypes.FormatKV, chunks, nil))
+
+	// restoring into a store with a different schema fails
+	chunks, err = source.Snapshot(1, snapshottypes.FormatKV)
+	require.NoError(t, err)
+	target, err := NewStore(memdb.NewDB(), simpleStoreConfig(t))
+	require.NoError(t, err)
+	require.Error(t, target.Restore(1, snapshottypes.FormatKV, chunks, nil))
+
+	// tampered contents do not match the snapshot root hash
+	target, err = NewStore(memdb.NewDB(), storeConfig123(t))
+	require.NoError(t, err)
+	chunks = makeSnapshotChunks(t, []*storetypes.SnapshotItem{
+		{Item: &storetypes.SnapshotItem_Schema{Schema: &storetypes.SnapshotSchema{
+			Keys:     []string{skey_1.Name(), skey_2.Name(), skey_3.Name()},
+			RootHash: source.LastCommitID().Hash,
+		}}},
+		{Item: &storetypes.SnapshotItem_Store{Store: &storetypes.SnapshotStoreItem{Name: skey_1.Name()}}},
+		{Item: &storetypes.SnapshotItem_KV{KV: &storetypes.SnapshotKVItem{Key: []byte("a"), Value: []byte("b")}}},
+	})
+	err = target.Restore(1, snapshottypes.FormatKV, chunks, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match snapshot root hash")
+	require.Equal(t, types.CommitID{}, target.LastCommitID())
+
+	// the rejected snapshot leaves nothing behind, and a valid one can then be restored
+	chunks, err = source.Snapshot(1, snapshottypes.FormatKV)
+	require.NoError(t, err)
+	require.NoError(t, target.Restore(1, snapshottypes.FormatKV, chunks, nil))
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	require.False(t, target.GetKVStore(skey_1).Has([]byte("a")))
+}
+
+func TestMultistoreRestoreTrusted(t *testing.T) {
+	source := newStore123WithData(t, 1)
+	appHash := source.LastCommitID().Hash
+
+	// a snapshot of different contents is consistent with its own root hash
+	tampered, err := NewStore(memdb.NewDB(), storeConfig123(t))
+	require.NoError(t, err)
+	tampered.GetKVStore(skey_1).Set([]byte("key001"), []byte("tampered"))
+	tampered.Commit()
+	chunks, err := tampered.Snapshot(1, snapshottypes.FormatKV)
+	require.NoError(t, err)
+
+	// but it is rejected when verified against the trusted app hash
+	target, err := NewStore(memdb.NewDB(), storeConfig123(t))
+	require.NoError(t, err)
+	err = target.RestoreTrusted(1, snapshottypes.FormatKV, appHash, chunks, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match trusted app hash")
+	require.Equal(t, types.CommitID{}, target.LastCommitID())
+
+	// the trusted app hash is required
+	chunks, err = source.Snapshot(1, snapshottypes.FormatKV)
+	require.NoError(t, err)
+	require.Error(t, target.RestoreTrusted(1, snapshottypes.FormatKV, nil, chunks, nil))
+	snapshots.DrainChunks(chunks)
+
+	// the snapshot matching the trusted app hash is restored over the rejected one
+	chunks, err = source.Snapshot(1, snapshottypes.FormatKV)
+	require.NoError(t, err)
+	require.NoError(t, target.RestoreTrusted(1, snapshottypes.FormatKV, appHash, chunks, nil))
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	value := target.GetKVStore(skey_1).Get([]byte("key001"))
+	require.Equal(t, []byte("value0-1"), value)
+}
+
+func makeSnapshotChunks(t *testing.T, items []*storetypes.SnapshotItem) <-chan io.ReadCloser {
+	var buf bytes.Buffer
+	zWriter := zlib.NewWriter(&buf)
+	protoWriter := protoio.NewDelimitedWriter(zWriter)
+	for _, item := range items {
+		require.NoError(t, protoWriter.WriteMsg(item))
+	}
+	require.NoError(t, protoWriter.Close())
+	ch := make(chan io.ReadCloser, 1)
+	ch <- io.NopCloser(&buf)
+	close(ch)
+	return ch
+}
@@ -8,15 +8,19 @@ import (
 	"strings"
 	"sync"
 
+	ics23 "github.com/confio/ics23/go"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	dbm "github.com/cosmos/cosmos-sdk/db"
 	prefixdb "github.com/cosmos/cosmos-sdk/db/prefix"
 	util "github.com/cosmos/cosmos-sdk/internal"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
+	sdkproofs "github.com/cosmos/cosmos-sdk/store/internal/proofs"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	v1 "github.com/cosmos/cosmos-sdk/store/types"
 	types "github.com/cosmos/cosmos-sdk/store/v2"
 	"github.com/cosmos/cosmos-sdk/store/v2/mem"
 	"github.com/cosmos/cosmos-sdk/store/v2/smt"
@@ -713,7 +717,15 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // If latest-1 is not present, use latest (which must be present)
 // if you care to have the latest data to see a tx results, you must
 // explicitly set the height you want to see
-func (rs *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
+	return queryVersion(req, rs.stateDB, rs.getView, rs.schema)
+}
+
+// Serves a query against a past version of a store persisted in stateDB, which is accessed
+// through getView. If schema is nil, the schema saved at the queried version is used.
+func queryVersion(
+	req abci.RequestQuery, stateDB dbm.DBConnection, getView func(int64) (*viewStore, error), schema StoreSchema,
+) (res abci.ResponseQuery) {
 	if len(req.Data) == 0 {
 		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"), false)
 	}
@@ -721,7 +733,7 @@ func (rs *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	// if height is 0, use the latest height
 	height := req.Height
 	if height == 0 {
-		versions, err := rs.stateDB.Versions()
+		versions, err := stateDB.Versions()
 		if err != nil {
 			return sdkerrors.QueryResult(errors.New("failed to get version info"), false)
 		}
@@ -741,7 +753,7 @@ func (rs *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	if err != nil {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(err, "failed to parse path"), false)
 	}
-	view, err := rs.getView(height)
+	view, err := getView(height)
 	if err != nil {
 		if errors.Is(err, dbm.ErrVersionDoesNotExist) {
 			err = sdkerrors.ErrInvalidHeight
@@ -749,7 +761,10 @@ func (rs *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(err, "failed to access height"), false)
 	}
 
-	if _, has := rs.schema[storeName]; !has {
+	if schema == nil {
+		schema = view.schema
+	}
+	if _, has := schema[storeName]; !has {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", storeName), false)
 	}
 	substore, err := view.getSubstore(storeName)
@@ -765,11 +780,21 @@ func (rs *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		if !req.Prove {
 			break
 		}
-		// TODO: actual IBC compatible proof. This is a placeholder so unit tests can pass
-		res.ProofOps, err = substore.stateCommitmentStore.GetProof([]byte(storeName + string(res.Key)))
+		// The proof consists of an ICS23 proof of the key's (non)existence within the substore SMT,
+		// followed by a proof of the substore's root within the Merkle map of all substores.
+		res.ProofOps, err = substore.stateCommitmentStore.GetProof(res.Key)
+		if err != nil {
+			return sdkerrors.QueryResult(fmt.Errorf("Merkle proof creation failed for key: %v: %w", res.Key, err), false) //nolint: stylecheck // proper name
+		}
+		storeHashes, err := view.getMerkleRoots()
 		if err != nil {
-			return sdkerrors.QueryResult(fmt.Errorf("Merkle proof creation failed for key: %v", res.Key), false) //nolint: stylecheck // proper name
+			return sdkerrors.QueryResult(err, false)
 		}
+		op, err := substoreProofOp(storeHashes, storeName)
+		if err != nil {
+			return sdkerrors.QueryResult(err, false)
+		}
+		res.ProofOps.Ops = append(res.ProofOps.Ops, op)
 
 	case "/subspace":
 		res.Key = req.Data // data holds the subspace prefix
@@ -800,6 +825,23 @@ func (rs *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 	return res
 }
 
+// Returns a proof of the existence of a substore's root hash within the Merkle map of all substores.
+func substoreProofOp(storeHashes map[string][]byte, storeName string) (tmcrypto.ProofOp, error) {
+	_, proofs, _ := sdkmaps.ProofsFromMap(storeHashes)
+	proof, has := proofs[storeName]
+	if !has {
+		return tmcrypto.ProofOp{}, ErrStoreNotFound(storeName)
+	}
+	exist, err := sdkproofs.ConvertExistenceProof(proof, []byte(storeName), storeHashes[storeName])
+	if err != nil {
+		return tmcrypto.ProofOp{}, fmt.Errorf("could not convert simple proof to existence proof: %w", err)
+	}
+	commitmentProof := &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{Exist: exist},
+	}
+	return v1.NewSimpleMerkleCommitmentOp([]byte(storeName), commitmentProof).ProofOp(), nil
+}
+
 func loadSMT(stateCommitmentTxn dbm.DBReadWriter, root []byte) *smt.Store {
 	merkleNodes := prefixdb.NewPrefixReadWriter(stateCommitmentTxn, merkleNodePrefix)
 	merkleValues := prefixdb.NewPrefixReadWriter(stateCommitmentTxn, merkleValuePrefix)
@@ -896,10 +938,3 @@ func (tlm *traceListenMixin) wrapTraceListen(store types.KVStore, skey types.Sto
 
 func (s *Store) GetPruning() types.PruningOptions   { return s.Pruning }
 func (s *Store) SetPruning(po types.PruningOptions) { s.Pruning = po }
-
-func (rs *Store) Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error {
-	return nil
-}
-func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
-	return nil, nil
-}
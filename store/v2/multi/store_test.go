@@ -8,11 +8,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	dbm "github.com/cosmos/cosmos-sdk/db"
 	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	types "github.com/cosmos/cosmos-sdk/store/v2"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
@@ -621,6 +623,30 @@ func TestQuery(t *testing.T) {
 			require.True(t, qres.IsOK(), qres.Log)
 			require.Equal(t, v1, qres.Value)
 			require.NotNil(t, qres.ProofOps)
+
+			// proofs verify against the root hash of the queried version
+			view, err := store.getView(qres.Height)
+			require.NoError(t, err)
+			root, err := view.stateView.Get(merkleRootKey)
+			require.NoError(t, err)
+			require.NoError(t, view.discard())
+			prt := rootmulti.SMTProofRuntime()
+			keyPath := func(key []byte) string {
+				return merkle.KeyPath{}.
+					AppendKey([]byte(skey_1.Name()), merkle.KeyEncodingURL).
+					AppendKey(key, merkle.KeyEncodingHex).String()
+			}
+			require.NoError(t, prt.VerifyValue(qres.ProofOps, root, keyPath(k1), v1))
+			require.Error(t, prt.VerifyValue(qres.ProofOps, root, keyPath(k1), v2))
+			require.Error(t, prt.VerifyAbsence(qres.ProofOps, root, keyPath(k1)))
+
+			// absent keys are proven with non-membership proofs
+			queryProve0.Data = []byte("absent")
+			qres = store.Query(queryProve0)
+			require.True(t, qres.IsOK(), qres.Log)
+			require.Nil(t, qres.Value)
+			require.NoError(t, prt.VerifyAbsence(qres.ProofOps, root, keyPath([]byte("absent"))))
+			require.Error(t, prt.VerifyValue(qres.ProofOps, root, keyPath([]byte("absent")), v1))
 		}
 		testProve()
 		store.Close()
@@ -630,6 +656,7 @@ func TestQuery(t *testing.T) {
 		store, err = NewStore(memdb.NewDB(), opts)
 		require.NoError(t, err)
 		store.GetKVStore(skey_1).Set(k1, v1)
+		store.GetKVStore(skey_1).Set(k2, v2)
 		store.Commit()
 		testProve()
 		store.Close()
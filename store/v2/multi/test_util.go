@@ -21,6 +21,7 @@ type dbVersionsIs struct {
 	vset dbm.VersionSet
 }
 type dbVersionsFails struct{ dbm.DBConnection }
+type dbWritesPanic struct{ dbm.DBConnection }
 type rwCommitFails struct{ dbm.DBReadWriter }
 type rwCrudFails struct {
 	dbm.DBReadWriter
@@ -43,6 +44,12 @@ func (db dbRevertFails) Revert() error {
 	}
 	return db.DBConnection.Revert()
 }
+func (dbWritesPanic) Writer() dbm.DBWriter              { panic("dbWritesPanic.Writer") }
+func (dbWritesPanic) ReadWriter() dbm.DBReadWriter      { panic("dbWritesPanic.ReadWriter") }
+func (dbWritesPanic) SaveNextVersion() (uint64, error)  { panic("dbWritesPanic.SaveNextVersion") }
+func (dbWritesPanic) SaveVersion(uint64) error          { panic("dbWritesPanic.SaveVersion") }
+func (dbWritesPanic) DeleteVersion(uint64) error        { panic("dbWritesPanic.DeleteVersion") }
+func (dbWritesPanic) Revert() error                     { panic("dbWritesPanic.Revert") }
 func (dbDeleteVersionFails) DeleteVersion(uint64) error { return errors.New("dbDeleteVersionFails") }
 func (tx rwCommitFails) Commit() error {
 	tx.Discard()
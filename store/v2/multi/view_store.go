@@ -84,8 +84,14 @@ func (st *viewSubstore) CacheWrapWithListeners(storeKey types.StoreKey, listener
 	return cachekv.NewStore(listenkv.NewStore(st, storeKey, listeners))
 }
 
-func (store *Store) getView(version int64) (ret *viewStore, err error) {
-	stateView, err := store.stateDB.ReaderAt(uint64(version))
+func (store *Store) getView(version int64) (*viewStore, error) {
+	return openView(store.stateDB, store.StateCommitmentDB, version)
+}
+
+// Opens a view of the given version of a store persisted in stateDB and, if not nil,
+// stateCommitmentDB.
+func openView(stateDB, stateCommitmentDB dbm.DBConnection, version int64) (ret *viewStore, err error) {
+	stateView, err := stateDB.ReaderAt(uint64(version))
 	if err != nil {
 		return
 	}
@@ -96,8 +102,8 @@ func (store *Store) getView(version int64) (ret *viewStore, err error) {
 	}()
 
 	stateCommitmentView := stateView
-	if store.StateCommitmentDB != nil {
-		stateCommitmentView, err = store.StateCommitmentDB.ReaderAt(uint64(version))
+	if stateCommitmentDB != nil {
+		stateCommitmentView, err = stateCommitmentDB.ReaderAt(uint64(version))
 		if err != nil {
 			return
 		}
@@ -141,6 +147,20 @@ func (vs *viewStore) GetKVStore(skey types.StoreKey) types.KVStore {
 	return ret
 }
 
+// Returns the root hashes of all substores in the schema, from which the root hash of the version is
+// calculated.
+func (vs *viewStore) getMerkleRoots() (map[string][]byte, error) {
+	ret := map[string][]byte{}
+	for key := range vs.schema {
+		sub, err := vs.getSubstore(key)
+		if err != nil {
+			return nil, err
+		}
+		ret[key] = sub.stateCommitmentStore.Root()
+	}
+	return ret, nil
+}
+
 // Reads but does not update substore cache
 func (vs *viewStore) getSubstore(key string) (*viewSubstore, error) {
 	if cached, has := vs.substoreCache[key]; has {
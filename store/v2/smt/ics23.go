@@ -0,0 +1,205 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+
+	ics23 "github.com/confio/ics23/go"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	leafPrefix  = []byte{0}
+	innerPrefix = []byte{1}
+	placeholder = make([]byte, sha256.Size)
+
+	errEmptyTree = errors.New("cannot prove absence of a key from an empty tree")
+)
+
+// ICS23 proofs are keyed by the path of a key within the tree, i.e. the SHA-256 hash of the key,
+// since leaves are ordered by path. The leaf and inner node hashing matches ics23.SmtSpec.
+
+// GetProofICS23 returns an ICS23 existence proof for a key if it is present, and otherwise a
+// non-existence proof consisting of existence proofs of the neighboring keys.
+func (s *Store) GetProofICS23(key []byte) (*ics23.CommitmentProof, error) {
+	if len(key) == 0 {
+		return nil, errKeyEmpty
+	}
+	path := keyPath(key)
+	value, err := s.values.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		exist, err := s.existenceProof(path, value)
+		if err != nil {
+			return nil, err
+		}
+		return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: exist}}, nil
+	}
+	nonexist, err := s.nonExistenceProof(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Nonexist{Nonexist: nonexist}}, nil
+}
+
+// GetBatchProofICS23 returns a compressed ICS23 batch proof of the existence or absence of each of
+// the given keys.
+func (s *Store) GetBatchProofICS23(keys [][]byte) (*ics23.CommitmentProof, error) {
+	proofs := make([]*ics23.CommitmentProof, 0, len(keys))
+	for _, key := range keys {
+		proof, err := s.GetProofICS23(key)
+		if err != nil {
+			return nil, err
+		}
+		proofs = append(proofs, proof)
+	}
+	batch, err := ics23.CombineProofs(proofs)
+	if err != nil {
+		return nil, err
+	}
+	return ics23.Compress(batch), nil
+}
+
+// BatchVerifyMembership verifies that a batch proof proves the existence of all the given key/value
+// pairs under root.
+func BatchVerifyMembership(root []byte, proof *ics23.CommitmentProof, items map[string][]byte) error {
+	paths := make(map[string][]byte, len(items))
+	for key, value := range items {
+		paths[string(keyPath([]byte(key)))] = value
+	}
+	if !ics23.BatchVerifyMembership(ics23.SmtSpec, root, proof, paths) {
+		return sdkerrors.Wrap(types.ErrInvalidProof, "proof did not verify existence of keys")
+	}
+	return nil
+}
+
+// BatchVerifyNonMembership verifies that a batch proof proves the absence of all the given keys
+// under root.
+func BatchVerifyNonMembership(root []byte, proof *ics23.CommitmentProof, keys [][]byte) error {
+	paths := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		paths = append(paths, keyPath(key))
+	}
+	if !ics23.BatchVerifyNonMembership(ics23.SmtSpec, root, proof, paths) {
+		return sdkerrors.Wrap(types.ErrInvalidProof, "proof did not verify absence of keys")
+	}
+	return nil
+}
+
+func keyPath(key []byte) []byte {
+	path := sha256.Sum256(key)
+	return path[:]
+}
+
+// Proves the existence of the leaf at a path with its value.
+func (s *Store) existenceProof(path, value []byte) (*ics23.ExistenceProof, error) {
+	sideNodes, leafPath, err := s.sideNodes(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(leafPath, path) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidProof, "no leaf found for path %X", path)
+	}
+	return &ics23.ExistenceProof{
+		Key:   path,
+		Value: value,
+		Leaf:  ics23.SmtSpec.LeafSpec,
+		Path:  innerOps(path, sideNodes),
+	}, nil
+}
+
+// Proves the absence of a path by proving the existence of its left and right neighbors.
+func (s *Store) nonExistenceProof(path []byte) (*ics23.NonExistenceProof, error) {
+	ret := &ics23.NonExistenceProof{Key: path}
+	var err error
+	ret.Left, err = s.neighborProof(s.values.ReverseIterator(nil, path))
+	if err != nil {
+		return nil, err
+	}
+	ret.Right, err = s.neighborProof(s.values.Iterator(path, nil))
+	if err != nil {
+		return nil, err
+	}
+	if ret.Left == nil && ret.Right == nil {
+		return nil, errEmptyTree
+	}
+	return ret, nil
+}
+
+// Proves the existence of the first leaf in an iterator over leaf values, if any.
+func (s *Store) neighborProof(iter dbm.Iterator, err error) (*ics23.ExistenceProof, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	if !iter.Next() {
+		return nil, iter.Error()
+	}
+	return s.existenceProof(iter.Key(), iter.Value())
+}
+
+// Returns the side nodes along a path, ordered from the leaf to the root, along with the path of the
+// leaf found at its end. The path is nil if the path ends at an empty subtree.
+func (s *Store) sideNodes(path []byte) ([][]byte, []byte, error) {
+	var sideNodes [][]byte
+	current := s.tree.Root()
+	for depth := 0; depth < len(path)*8; depth++ {
+		if bytes.Equal(current, placeholder) {
+			break
+		}
+		data, err := s.nodes.Get(current)
+		if err != nil {
+			return nil, nil, err
+		}
+		if data == nil {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidProof, "missing tree node %X", current)
+		}
+		if bytes.HasPrefix(data, leafPrefix) {
+			reverse(sideNodes)
+			return sideNodes, data[len(leafPrefix) : len(leafPrefix)+sha256.Size], nil
+		}
+		left, right := data[len(innerPrefix):len(innerPrefix)+sha256.Size], data[len(innerPrefix)+sha256.Size:]
+		if bitAt(path, depth) == 1 {
+			sideNodes = append(sideNodes, left)
+			current = right
+		} else {
+			sideNodes = append(sideNodes, right)
+			current = left
+		}
+	}
+	reverse(sideNodes)
+	return sideNodes, nil, nil
+}
+
+// Converts the side nodes of a path, ordered from the leaf to the root, to ICS23 inner ops.
+func innerOps(path []byte, sideNodes [][]byte) []*ics23.InnerOp {
+	ret := make([]*ics23.InnerOp, 0, len(sideNodes))
+	for i, sideNode := range sideNodes {
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if bitAt(path, len(sideNodes)-1-i) == 1 {
+			op.Prefix = append(append([]byte{}, innerPrefix...), sideNode...)
+		} else {
+			op.Prefix = innerPrefix
+			op.Suffix = sideNode
+		}
+		ret = append(ret, op)
+	}
+	return ret
+}
+
+// Returns the bit of data at a position, counting from the most significant bit.
+func bitAt(data []byte, position int) int {
+	return int(data[position/8]>>(7-uint(position%8))) & 1
+}
+
+func reverse(nodes [][]byte) {
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+}
@@ -0,0 +1,123 @@
+package smt_test
+
+import (
+	"fmt"
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	store "github.com/cosmos/cosmos-sdk/store/v2/smt"
+)
+
+func TestProofICS23(t *testing.T) {
+	s := store.NewStore(memdb.NewDB().ReadWriter(), memdb.NewDB().ReadWriter())
+
+	// absence can't be proven for an empty tree
+	_, err := s.GetProofICS23([]byte("foo"))
+	require.Error(t, err)
+	_, err = s.GetProofICS23(nil)
+	require.Error(t, err)
+
+	// with a single leaf, absent keys only have one neighbor
+	s.Set([]byte("foo"), []byte("bar"))
+	testProofs(t, s, map[string][]byte{"foo": []byte("bar")}, []string{"baz", "qux"})
+
+	items := map[string][]byte{}
+	var absent []string
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("key%d", i)
+		if i%3 == 0 {
+			absent = append(absent, key)
+			continue
+		}
+		items[key] = []byte(fmt.Sprintf("value%d", i))
+		s.Set([]byte(key), items[key])
+	}
+	items["foo"] = []byte("bar")
+	testProofs(t, s, items, absent)
+
+	// deleted keys are absent
+	s.Delete([]byte("foo"))
+	delete(items, "foo")
+	testProofs(t, s, items, append(absent, "foo"))
+}
+
+func testProofs(t *testing.T, s *store.Store, items map[string][]byte, absent []string) {
+	root := s.Root()
+	for key, value := range items {
+		proofs, err := s.GetProof([]byte(key))
+		require.NoError(t, err)
+		require.Len(t, proofs.Ops, 1)
+		op, err := types.CommitmentOpDecoder(proofs.Ops[0])
+		require.NoError(t, err)
+		require.Equal(t, []byte(key), op.GetKey())
+
+		res, err := op.Run([][]byte{value})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{root}, res)
+		_, err = op.Run([][]byte{[]byte("wrong")})
+		require.Error(t, err)
+		_, err = op.Run(nil)
+		require.Error(t, err)
+	}
+	for _, key := range absent {
+		proofs, err := s.GetProof([]byte(key))
+		require.NoError(t, err)
+		op, err := types.CommitmentOpDecoder(proofs.Ops[0])
+		require.NoError(t, err)
+
+		res, err := op.Run(nil)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{root}, res)
+		_, err = op.Run([][]byte{[]byte("value")})
+		require.Error(t, err)
+	}
+
+	// a batch proof covers all keys, and is smaller than the individual proofs
+	var keys [][]byte
+	var size int
+	for key := range items {
+		keys = append(keys, []byte(key))
+	}
+	for _, key := range absent {
+		keys = append(keys, []byte(key))
+	}
+	for _, key := range keys {
+		proof, err := s.GetProofICS23(key)
+		require.NoError(t, err)
+		size += proof.Size()
+	}
+	batch, err := s.GetBatchProofICS23(keys)
+	require.NoError(t, err)
+	require.NotNil(t, batch.GetCompressed())
+	if len(keys) > 10 {
+		require.Less(t, batch.Size(), size)
+	}
+	calculated, err := batch.Calculate()
+	require.NoError(t, err)
+	require.Equal(t, root, []byte(calculated))
+
+	require.NoError(t, store.BatchVerifyMembership(root, batch, items))
+	var absentKeys [][]byte
+	for _, key := range absent {
+		absentKeys = append(absentKeys, []byte(key))
+	}
+	require.NoError(t, store.BatchVerifyNonMembership(root, batch, absentKeys))
+	if len(absent) > 0 {
+		require.Error(t, store.BatchVerifyMembership(root, batch, map[string][]byte{absent[0]: []byte("value")}))
+	}
+	for key := range items {
+		require.Error(t, store.BatchVerifyNonMembership(root, batch, [][]byte{[]byte(key)}))
+		break
+	}
+
+	// the batch proof survives encoding
+	bz, err := batch.Marshal()
+	require.NoError(t, err)
+	var decoded ics23.CommitmentProof
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.NoError(t, store.BatchVerifyMembership(root, &decoded, items))
+}
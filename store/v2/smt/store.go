@@ -24,6 +24,8 @@ var (
 // Store Implements types.KVStore and CommitKVStore.
 type Store struct {
 	tree *smt.SparseMerkleTree
+	// Tree nodes and leaf values, keyed by node hash and key path (hash) respectively
+	nodes, values dbm.DBReadWriter
 }
 
 // An smt.MapStore that wraps Get to raise smt.InvalidKeyError;
@@ -32,22 +34,28 @@ type dbMapStore struct{ dbm.DBReadWriter }
 
 func NewStore(nodes, values dbm.DBReadWriter) *Store {
 	return &Store{
-		tree: smt.NewSparseMerkleTree(dbMapStore{nodes}, dbMapStore{values}, sha256.New()),
+		tree:   smt.NewSparseMerkleTree(dbMapStore{nodes}, dbMapStore{values}, sha256.New()),
+		nodes:  nodes,
+		values: values,
 	}
 }
 
 func LoadStore(nodes, values dbm.DBReadWriter, root []byte) *Store {
 	return &Store{
-		tree: smt.ImportSparseMerkleTree(dbMapStore{nodes}, dbMapStore{values}, sha256.New(), root),
+		tree:   smt.ImportSparseMerkleTree(dbMapStore{nodes}, dbMapStore{values}, sha256.New(), root),
+		nodes:  nodes,
+		values: values,
 	}
 }
 
+// GetProof returns an ICS23 proof of the existence or absence of a key, as ProofOps containing a
+// single SMT CommitmentOp.
 func (s *Store) GetProof(key []byte) (*tmcrypto.ProofOps, error) {
-	proof, err := s.tree.Prove(key)
+	proof, err := s.GetProofICS23(key)
 	if err != nil {
 		return nil, err
 	}
-	op := NewProofOp(s.tree.Root(), key, SHA256, proof)
+	op := types.NewSmtCommitmentOp(key, proof)
 	return &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{op.ProofOp()}}, nil
 }
 
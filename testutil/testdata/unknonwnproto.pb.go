@@ -2569,7 +2569,8 @@ type TestUpdatedTxBody struct {
 	Messages                     []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
-	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	Unordered                    bool         `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	SomeNewField                 uint64       `protobuf:"varint,5,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*types.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*types.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -2629,6 +2630,13 @@ func (m *TestUpdatedTxBody) GetTimeoutHeight() int64 {
 	return 0
 }
 
+func (m *TestUpdatedTxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
 func (m *TestUpdatedTxBody) GetSomeNewField() uint64 {
 	if m != nil {
 		return m.SomeNewField
@@ -2809,110 +2817,111 @@ func init() {
 func init() { proto.RegisterFile("unknonwnproto.proto", fileDescriptor_448ea787339d1228) }
 
 var fileDescriptor_448ea787339d1228 = []byte{
-	// 1644 bytes of a gzipped FileDescriptorProto
+	// 1652 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
 	0x15, 0xd7, 0x70, 0x49, 0x89, 0x7c, 0xa2, 0x69, 0x66, 0x6c, 0xb4, 0x1b, 0x3a, 0x66, 0x98, 0x85,
 	0xeb, 0xb0, 0x41, 0x43, 0x9a, 0x4b, 0x06, 0x28, 0x72, 0x32, 0xe9, 0x58, 0x95, 0x01, 0x57, 0x2e,
 	0xa6, 0x4e, 0x5a, 0xf8, 0x42, 0x2c, 0xb9, 0x43, 0x72, 0x21, 0x72, 0x46, 0xdd, 0x99, 0xb5, 0xc8,
-	0x5b, 0xd1, 0x1e, 0x7a, 0xcd, 0xa5, 0x28, 0xd0, 0x6f, 0xd0, 0x53, 0x91, 0x6f, 0xd0, 0xa3, 0x2f,
-	0x05, 0x7c, 0x29, 0x50, 0xa0, 0x40, 0x50, 0xd8, 0xd7, 0x7e, 0x83, 0xa2, 0x48, 0x31, 0xb3, 0x7f,
-	0xb8, 0x94, 0x44, 0x85, 0x52, 0xda, 0x18, 0x02, 0x72, 0x11, 0x67, 0xde, 0xfe, 0xe6, 0xcd, 0x7b,
-	0xbf, 0xf7, 0x67, 0x77, 0x46, 0x70, 0x23, 0x60, 0x87, 0x8c, 0xb3, 0x63, 0x76, 0xe4, 0x73, 0xc9,
-	0x1b, 0xfa, 0x2f, 0xce, 0x4b, 0x2a, 0xa4, 0xeb, 0x48, 0xa7, 0x72, 0x73, 0xcc, 0xc7, 0x5c, 0x0b,
-	0x9b, 0x6a, 0x14, 0x3e, 0xaf, 0xbc, 0x3d, 0xe6, 0x7c, 0x3c, 0xa5, 0x4d, 0x3d, 0x1b, 0x04, 0xa3,
-	0xa6, 0xc3, 0x16, 0xd1, 0xa3, 0xca, 0x90, 0x8b, 0x19, 0x17, 0x4d, 0x39, 0x6f, 0x3e, 0x6f, 0x0d,
-	0xa8, 0x74, 0x5a, 0x4d, 0x39, 0x0f, 0x9f, 0x59, 0x12, 0x0a, 0x0f, 0x02, 0x21, 0xf9, 0x8c, 0xfa,
-	0x2d, 0x5c, 0x82, 0x8c, 0xe7, 0x9a, 0xa8, 0x86, 0xea, 0x39, 0x92, 0xf1, 0x5c, 0x8c, 0x21, 0xcb,
-	0x9c, 0x19, 0x35, 0x33, 0x35, 0x54, 0x2f, 0x10, 0x3d, 0xc6, 0x3f, 0x84, 0xb2, 0x08, 0x06, 0x62,
-	0xe8, 0x7b, 0x47, 0xd2, 0xe3, 0xac, 0x3f, 0xa2, 0xd4, 0x34, 0x6a, 0xa8, 0x9e, 0x21, 0xd7, 0xd3,
-	0xf2, 0x3d, 0x4a, 0xb1, 0x09, 0x3b, 0x47, 0xce, 0x62, 0x46, 0x99, 0x34, 0x77, 0xb4, 0x86, 0x78,
-	0x6a, 0x7d, 0x91, 0x59, 0x6e, 0x6b, 0x9f, 0xda, 0xb6, 0x02, 0x79, 0x8f, 0xb9, 0x81, 0x90, 0xfe,
-	0x42, 0x6f, 0x9d, 0x23, 0xc9, 0x3c, 0x31, 0xc9, 0x48, 0x99, 0x74, 0x13, 0x72, 0x23, 0x7a, 0x4c,
-	0x7d, 0x33, 0xab, 0xed, 0x08, 0x27, 0xf8, 0x16, 0xe4, 0x7d, 0x2a, 0xa8, 0xff, 0x9c, 0xba, 0xe6,
-	0x1f, 0xf2, 0x35, 0x54, 0x37, 0x48, 0x22, 0xc0, 0x3f, 0x82, 0xec, 0xd0, 0x93, 0x0b, 0x73, 0xbb,
-	0x86, 0xea, 0x25, 0xdb, 0x6c, 0xc4, 0xe4, 0x36, 0x12, 0xab, 0x1a, 0x0f, 0x3c, 0xb9, 0x20, 0x1a,
+	0x5b, 0xd1, 0x1e, 0x7a, 0xed, 0xa5, 0x28, 0xd0, 0x6f, 0xd0, 0x4b, 0x8b, 0x7c, 0x83, 0x1e, 0x7d,
+	0x29, 0xe0, 0x4b, 0x81, 0x02, 0x05, 0x82, 0xc2, 0xbe, 0xf6, 0x1b, 0x14, 0x45, 0x8a, 0x99, 0xfd,
+	0xc3, 0xa5, 0x24, 0x2a, 0x94, 0xd2, 0xc6, 0x10, 0x90, 0x8b, 0x38, 0xf3, 0xf6, 0x37, 0xef, 0xbd,
+	0xf9, 0xbd, 0x3f, 0xbb, 0x33, 0x82, 0x1b, 0x01, 0x3b, 0x64, 0x9c, 0x1d, 0xb3, 0x23, 0x9f, 0x4b,
+	0xde, 0xd0, 0x7f, 0x71, 0x5e, 0x52, 0x21, 0x5d, 0x47, 0x3a, 0x95, 0x9b, 0x63, 0x3e, 0xe6, 0x5a,
+	0xd8, 0x54, 0xa3, 0xf0, 0x79, 0xe5, 0xed, 0x31, 0xe7, 0xe3, 0x29, 0x6d, 0xea, 0xd9, 0x20, 0x18,
+	0x35, 0x1d, 0xb6, 0x88, 0x1e, 0x55, 0x86, 0x5c, 0xcc, 0xb8, 0x68, 0xca, 0x79, 0xf3, 0x79, 0x6b,
+	0x40, 0xa5, 0xd3, 0x6a, 0xca, 0x79, 0xf8, 0xcc, 0x92, 0x50, 0x78, 0x10, 0x08, 0xc9, 0x67, 0xd4,
+	0x6f, 0xe1, 0x12, 0x64, 0x3c, 0xd7, 0x44, 0x35, 0x54, 0xcf, 0x91, 0x8c, 0xe7, 0x62, 0x0c, 0x59,
+	0xe6, 0xcc, 0xa8, 0x99, 0xa9, 0xa1, 0x7a, 0x81, 0xe8, 0x31, 0xfe, 0x3e, 0x94, 0x45, 0x30, 0x10,
+	0x43, 0xdf, 0x3b, 0x92, 0x1e, 0x67, 0xfd, 0x11, 0xa5, 0xa6, 0x51, 0x43, 0xf5, 0x0c, 0xb9, 0x9e,
+	0x96, 0xef, 0x51, 0x8a, 0x4d, 0xd8, 0x39, 0x72, 0x16, 0x33, 0xca, 0xa4, 0xb9, 0xa3, 0x35, 0xc4,
+	0x53, 0xeb, 0xf3, 0xcc, 0xd2, 0xac, 0x7d, 0xca, 0x6c, 0x05, 0xf2, 0x1e, 0x73, 0x03, 0x21, 0xfd,
+	0x85, 0x36, 0x9d, 0x23, 0xc9, 0x3c, 0x71, 0xc9, 0x48, 0xb9, 0x74, 0x13, 0x72, 0x23, 0x7a, 0x4c,
+	0x7d, 0x33, 0xab, 0xfd, 0x08, 0x27, 0xf8, 0x16, 0xe4, 0x7d, 0x2a, 0xa8, 0xff, 0x9c, 0xba, 0xe6,
+	0xef, 0xf3, 0x35, 0x54, 0x37, 0x48, 0x22, 0xc0, 0x3f, 0x80, 0xec, 0xd0, 0x93, 0x0b, 0x73, 0xbb,
+	0x86, 0xea, 0x25, 0xdb, 0x6c, 0xc4, 0xe4, 0x36, 0x12, 0xaf, 0x1a, 0x0f, 0x3c, 0xb9, 0x20, 0x1a,
 	0x85, 0x3f, 0x86, 0x6b, 0x33, 0x4f, 0x0c, 0xe9, 0x74, 0xea, 0x30, 0xca, 0x03, 0x61, 0x42, 0x0d,
 	0xd5, 0x77, 0xed, 0x9b, 0x8d, 0x90, 0xf3, 0x46, 0xcc, 0x79, 0xa3, 0xcb, 0x16, 0x64, 0x15, 0x6a,
-	0xfd, 0x04, 0xb2, 0x4a, 0x13, 0xce, 0x43, 0xf6, 0xb1, 0xc3, 0x45, 0x79, 0x0b, 0x97, 0x00, 0x1e,
-	0x73, 0xd1, 0x65, 0x63, 0x3a, 0xa5, 0xa2, 0x8c, 0x70, 0x11, 0xf2, 0x3f, 0x73, 0xa6, 0xbc, 0x3b,
-	0x95, 0xbc, 0x9c, 0xc1, 0x00, 0xdb, 0x3f, 0xe5, 0x62, 0xc8, 0x8f, 0xcb, 0x06, 0xde, 0x85, 0x9d,
+	0xfd, 0x08, 0xb2, 0x4a, 0x13, 0xce, 0x43, 0xf6, 0xb1, 0xc3, 0x45, 0x79, 0x0b, 0x97, 0x00, 0x1e,
+	0x73, 0xd1, 0x65, 0x63, 0x3a, 0xa5, 0xa2, 0x8c, 0x70, 0x11, 0xf2, 0x3f, 0x71, 0xa6, 0xbc, 0x3b,
+	0x95, 0xbc, 0x9c, 0xc1, 0x00, 0xdb, 0x3f, 0xe6, 0x62, 0xc8, 0x8f, 0xcb, 0x06, 0xde, 0x85, 0x9d,
 	0x03, 0xc7, 0xf3, 0xf9, 0xc0, 0x2b, 0x67, 0xad, 0x06, 0xe4, 0x0f, 0xa8, 0x90, 0xd4, 0xed, 0x74,
 	0x37, 0x09, 0x94, 0xf5, 0x37, 0x14, 0x2f, 0x68, 0x6f, 0xb4, 0x00, 0x5b, 0x90, 0x71, 0x3a, 0x66,
-	0xb6, 0x66, 0xd4, 0x77, 0x6d, 0xbc, 0x64, 0x24, 0xde, 0x94, 0x64, 0x9c, 0x0e, 0x6e, 0x43, 0xce,
-	0x63, 0x2e, 0x9d, 0x9b, 0x39, 0x0d, 0xbb, 0x7d, 0x12, 0xd6, 0xee, 0x36, 0x1e, 0xa9, 0xe7, 0x0f,
-	0x99, 0xf4, 0x17, 0x24, 0xc4, 0x56, 0x1e, 0x03, 0x2c, 0x85, 0xb8, 0x0c, 0xc6, 0x21, 0x5d, 0x68,
-	0x5b, 0x0c, 0xa2, 0x86, 0xb8, 0x0e, 0xb9, 0xe7, 0xce, 0x34, 0x08, 0xad, 0x39, 0x7b, 0xef, 0x10,
-	0xf0, 0x71, 0xe6, 0xc7, 0xc8, 0x7a, 0x16, 0xbb, 0x65, 0x6f, 0xe6, 0xd6, 0x07, 0xb0, 0xcd, 0x34,
-	0x5e, 0xe7, 0xcc, 0x19, 0xea, 0xdb, 0x5d, 0x12, 0x21, 0xac, 0xbd, 0x58, 0x77, 0xeb, 0xb4, 0xee,
-	0xa5, 0x9e, 0x35, 0x66, 0xda, 0x4b, 0x3d, 0xf7, 0x93, 0x58, 0xf5, 0x4e, 0xe9, 0x29, 0x83, 0xe1,
-	0x8c, 0x69, 0x94, 0xd8, 0x6a, 0x78, 0x56, 0x4e, 0x5b, 0x6e, 0x12, 0xbc, 0x4b, 0x6a, 0x50, 0xe1,
-	0x1c, 0xac, 0x0f, 0x67, 0x8f, 0x64, 0x06, 0x1d, 0x8b, 0x25, 0x5c, 0x9e, 0xb9, 0x8b, 0xaa, 0x6d,
-	0xb5, 0x0b, 0x22, 0x6a, 0xb8, 0x01, 0x93, 0xbd, 0x98, 0x01, 0x55, 0x93, 0x3e, 0x0f, 0x24, 0xd5,
-	0x35, 0x59, 0x20, 0xe1, 0xc4, 0xfa, 0x65, 0xc2, 0x6f, 0xef, 0x12, 0xfc, 0x2e, 0xb5, 0x47, 0x0c,
-	0x18, 0x09, 0x03, 0xd6, 0x6f, 0x52, 0x1d, 0xa5, 0xbd, 0x51, 0x5e, 0x94, 0x20, 0x23, 0x46, 0x51,
-	0xeb, 0xca, 0x88, 0x11, 0x7e, 0x07, 0x0a, 0x22, 0xf0, 0x87, 0x13, 0xc7, 0x1f, 0xd3, 0xa8, 0x93,
-	0x2c, 0x05, 0xb8, 0x06, 0xbb, 0x2e, 0x15, 0xd2, 0x63, 0x8e, 0xea, 0x6e, 0x66, 0x4e, 0x2b, 0x4a,
-	0x8b, 0xf0, 0x5d, 0x28, 0x0d, 0x7d, 0xea, 0x7a, 0xb2, 0x3f, 0x74, 0x7c, 0xb7, 0xcf, 0x78, 0xd8,
-	0xf4, 0xf6, 0xb7, 0x48, 0x31, 0x94, 0x3f, 0x70, 0x7c, 0xf7, 0x80, 0xe3, 0xdb, 0x50, 0x18, 0x4e,
-	0xe8, 0xaf, 0x02, 0xaa, 0x20, 0xf9, 0x08, 0x92, 0x0f, 0x45, 0x07, 0x1c, 0x37, 0x21, 0xcf, 0x7d,
-	0x6f, 0xec, 0x31, 0x67, 0x6a, 0x16, 0x34, 0x11, 0x37, 0x4e, 0x77, 0xa7, 0x16, 0x49, 0x40, 0xbd,
-	0x42, 0xd2, 0x65, 0xad, 0x7f, 0x65, 0xa0, 0xf8, 0x94, 0x0a, 0xf9, 0x19, 0xf5, 0x85, 0xc7, 0x59,
-	0x0b, 0x17, 0x01, 0xcd, 0xa3, 0x4a, 0x43, 0x73, 0x7c, 0x07, 0x90, 0x13, 0x91, 0xfb, 0xbd, 0xa5,
-	0xce, 0xf4, 0x02, 0x82, 0x1c, 0x85, 0x1a, 0x44, 0x01, 0x5e, 0x8b, 0x1a, 0x28, 0xd4, 0x30, 0x4a,
-	0xae, 0xb5, 0xa8, 0x21, 0xfe, 0x00, 0x90, 0x1b, 0xb5, 0x8a, 0x35, 0xa8, 0x5e, 0xf6, 0xc5, 0x97,
-	0xef, 0x6e, 0x11, 0xe4, 0xe2, 0x12, 0x20, 0xaa, 0xfb, 0x71, 0x6e, 0x7f, 0x8b, 0x20, 0x8a, 0xef,
-	0x02, 0x1a, 0x69, 0x0a, 0xd7, 0xae, 0x55, 0xb8, 0x11, 0xb6, 0x00, 0x8d, 0x35, 0x8f, 0xeb, 0x1a,
-	0x32, 0x1a, 0x2b, 0x6b, 0x27, 0x66, 0xe1, 0x7c, 0x6b, 0x27, 0xf8, 0x7d, 0x40, 0x87, 0x66, 0x71,
-	0x2d, 0xe7, 0xbd, 0xec, 0xcb, 0x2f, 0xdf, 0x45, 0x04, 0x1d, 0xf6, 0x72, 0x60, 0x88, 0x60, 0x66,
-	0xfd, 0xd6, 0x58, 0xa1, 0xdb, 0xbe, 0x28, 0xdd, 0xf6, 0x46, 0x74, 0xdb, 0x1b, 0xd1, 0x6d, 0x2b,
-	0xba, 0xef, 0x7c, 0x1d, 0xdd, 0xf6, 0xa5, 0x88, 0xb6, 0xdf, 0x14, 0xd1, 0xf8, 0x16, 0x14, 0x18,
-	0x3d, 0xee, 0x8f, 0x3c, 0x3a, 0x75, 0xcd, 0xb7, 0x6b, 0xa8, 0x9e, 0x25, 0x79, 0x46, 0x8f, 0xf7,
-	0xd4, 0x3c, 0x8e, 0xc2, 0xef, 0x57, 0xa3, 0xd0, 0xbe, 0x68, 0x14, 0xda, 0x1b, 0x45, 0xa1, 0xbd,
-	0x51, 0x14, 0xda, 0x1b, 0x45, 0xa1, 0x7d, 0xa9, 0x28, 0xb4, 0xdf, 0x58, 0x14, 0x3e, 0x04, 0xcc,
-	0x38, 0xeb, 0x0f, 0x7d, 0x4f, 0x7a, 0x43, 0x67, 0x1a, 0x85, 0xe3, 0x77, 0xba, 0x77, 0x91, 0x32,
-	0xe3, 0xec, 0x41, 0xf4, 0x64, 0x25, 0x2e, 0xff, 0xce, 0x40, 0x25, 0x6d, 0xfe, 0x63, 0xce, 0xe8,
-	0x13, 0x46, 0x9f, 0x8c, 0x3e, 0x53, 0xaf, 0xf2, 0x2b, 0x1a, 0xa5, 0x2b, 0xc3, 0xfe, 0x7f, 0xb6,
-	0xe1, 0xfb, 0x27, 0xd9, 0x3f, 0xd0, 0x6f, 0xab, 0xf1, 0x15, 0xa1, 0xbe, 0xb5, 0x2c, 0x88, 0xf7,
-	0xce, 0x46, 0xa5, 0x7c, 0xba, 0x22, 0xb5, 0x81, 0xef, 0xc3, 0xb6, 0xc7, 0x18, 0xf5, 0x5b, 0x66,
-	0x49, 0x2b, 0xaf, 0x7f, 0xad, 0x67, 0x8d, 0x47, 0x1a, 0x4f, 0xa2, 0x75, 0x89, 0x06, 0xdb, 0xbc,
-	0x7e, 0x21, 0x0d, 0x76, 0xa4, 0xc1, 0xae, 0xfc, 0x09, 0xc1, 0x76, 0xa8, 0x34, 0xf5, 0x9d, 0x64,
-	0xac, 0xfd, 0x4e, 0x7a, 0xa4, 0x3e, 0xf9, 0x19, 0xf5, 0xa3, 0xe8, 0xb7, 0x37, 0xb5, 0x38, 0xfc,
-	0xd1, 0x7f, 0x48, 0xa8, 0xa1, 0x72, 0x4f, 0x1d, 0x04, 0x62, 0x61, 0x6a, 0xf3, 0x42, 0xbc, 0xb9,
-	0x3e, 0x93, 0x45, 0x9b, 0xab, 0x71, 0xe5, 0xcf, 0xb1, 0xad, 0xf6, 0x29, 0xb8, 0x09, 0x3b, 0x43,
-	0x1e, 0xb0, 0xf8, 0x90, 0x58, 0x20, 0xf1, 0xf4, 0xb2, 0x16, 0xdb, 0xff, 0x0b, 0x8b, 0xe3, 0xfa,
-	0xfb, 0x6a, 0xb5, 0xfe, 0x3a, 0xdf, 0xd5, 0xdf, 0x15, 0xaa, 0xbf, 0xce, 0x37, 0xae, 0xbf, 0xce,
-	0xb7, 0x5c, 0x7f, 0x9d, 0x6f, 0x54, 0x7f, 0xc6, 0xda, 0xfa, 0xfb, 0xe2, 0xff, 0x56, 0x7f, 0x9d,
-	0x8d, 0xea, 0xcf, 0x3e, 0xb7, 0xfe, 0x6e, 0xa6, 0x2f, 0x0e, 0x8c, 0xe8, 0x92, 0x20, 0xae, 0xc0,
-	0xbf, 0x22, 0x28, 0xa5, 0xf6, 0xdb, 0xfb, 0xe4, 0x72, 0xc7, 0xa1, 0x37, 0x7e, 0x2c, 0x89, 0xfd,
-	0xf9, 0x07, 0x5a, 0xf9, 0x9e, 0xda, 0xfb, 0xa4, 0xf5, 0x0b, 0x4f, 0x4e, 0x1e, 0xce, 0xa5, 0xef,
-	0x74, 0xd9, 0xe2, 0x5b, 0xf5, 0xed, 0xce, 0xd2, 0xb7, 0x14, 0xae, 0xcb, 0x16, 0x89, 0x45, 0x17,
-	0xf6, 0xee, 0x29, 0x14, 0xd3, 0xeb, 0x71, 0x5d, 0x39, 0x80, 0xd6, 0xd3, 0x17, 0x77, 0x00, 0x47,
-	0x39, 0x1e, 0x76, 0x46, 0x43, 0x75, 0xc0, 0x62, 0xd8, 0x01, 0xf5, 0x6c, 0x68, 0xfd, 0x05, 0x41,
-	0x59, 0x6d, 0xf8, 0xe9, 0x91, 0xeb, 0x48, 0xea, 0x3e, 0x9d, 0x13, 0xe7, 0x18, 0xdf, 0x06, 0x18,
-	0x70, 0x77, 0xd1, 0x1f, 0x2c, 0x24, 0x15, 0x7a, 0x8f, 0x22, 0x29, 0x28, 0x49, 0x4f, 0x09, 0xf0,
-	0x5d, 0xb8, 0xee, 0x04, 0x72, 0xd2, 0xf7, 0xd8, 0x88, 0x47, 0x98, 0x8c, 0xc6, 0x5c, 0x53, 0xe2,
-	0x47, 0x6c, 0xc4, 0x43, 0x5c, 0x15, 0x40, 0x78, 0x63, 0xe6, 0xc8, 0xc0, 0xa7, 0xc2, 0x34, 0x6a,
-	0x46, 0xbd, 0x48, 0x52, 0x12, 0x5c, 0x85, 0xdd, 0xe4, 0xec, 0xd2, 0xff, 0x48, 0xdf, 0x18, 0x14,
-	0x49, 0x21, 0x3e, 0xbd, 0x7c, 0x84, 0x7f, 0x00, 0xa5, 0xe5, 0xf3, 0xd6, 0x3d, 0xbb, 0x63, 0xfe,
-	0x3a, 0xaf, 0x31, 0xc5, 0x18, 0xa3, 0x84, 0xd6, 0xe7, 0x06, 0xbc, 0xb5, 0xe2, 0x42, 0x8f, 0xbb,
-	0x0b, 0x7c, 0x0f, 0xf2, 0x33, 0x2a, 0x84, 0x33, 0xd6, 0x1e, 0x18, 0x6b, 0x93, 0x2c, 0x41, 0xa9,
-	0xea, 0x9e, 0xd1, 0x19, 0x8f, 0xab, 0x5b, 0x8d, 0x95, 0x09, 0xd2, 0x9b, 0x51, 0x1e, 0xc8, 0xfe,
-	0x84, 0x7a, 0xe3, 0x89, 0x8c, 0x78, 0xbc, 0x16, 0x49, 0xf7, 0xb5, 0x10, 0xdf, 0x81, 0x92, 0xe0,
-	0x33, 0xda, 0x5f, 0x1e, 0xc5, 0xb2, 0xfa, 0x28, 0x56, 0x54, 0xd2, 0x83, 0xc8, 0x58, 0xbc, 0x0f,
-	0xef, 0xad, 0xa2, 0xfa, 0x67, 0x34, 0xe6, 0x3f, 0x86, 0x8d, 0xf9, 0x9d, 0xf4, 0xca, 0x83, 0x93,
-	0x4d, 0xba, 0x07, 0x6f, 0xd1, 0xb9, 0xa4, 0x4c, 0xe5, 0x48, 0x9f, 0xeb, 0xeb, 0x64, 0x61, 0x7e,
-	0xb5, 0x73, 0x8e, 0x9b, 0xe5, 0x04, 0xff, 0x24, 0x84, 0xe3, 0x67, 0x50, 0x5d, 0xd9, 0xfe, 0x0c,
-	0x85, 0xd7, 0xcf, 0x51, 0x78, 0x2b, 0xf5, 0xe6, 0x78, 0x78, 0x42, 0xb7, 0xf5, 0x02, 0xc1, 0x8d,
-	0x54, 0x48, 0xba, 0x51, 0x5a, 0xe0, 0xfb, 0x50, 0x54, 0xf1, 0xa7, 0xbe, 0xce, 0x9d, 0x38, 0x30,
-	0xb7, 0x1b, 0xe1, 0xf5, 0x7b, 0x43, 0xce, 0x1b, 0xd1, 0xf5, 0x7b, 0xe3, 0xe7, 0x1a, 0xa6, 0x16,
-	0x91, 0x5d, 0x91, 0x8c, 0x05, 0xae, 0x2f, 0xef, 0xdc, 0x54, 0xd1, 0x9c, 0x5e, 0xb8, 0x47, 0x69,
-	0x78, 0x17, 0xb7, 0x92, 0x5d, 0x6d, 0x1d, 0xb7, 0x54, 0x76, 0xb5, 0x37, 0xcd, 0xae, 0xf7, 0xc3,
-	0xe4, 0x22, 0xf4, 0x88, 0x2a, 0x57, 0x3e, 0xf5, 0x98, 0xd4, 0xa9, 0xc2, 0x82, 0x59, 0x68, 0x7f,
-	0x96, 0xe8, 0x71, 0x6f, 0xff, 0xc5, 0xab, 0x2a, 0x7a, 0xf9, 0xaa, 0x8a, 0xfe, 0xf9, 0xaa, 0x8a,
-	0x3e, 0x7f, 0x5d, 0xdd, 0x7a, 0xf9, 0xba, 0xba, 0xf5, 0xf7, 0xd7, 0xd5, 0xad, 0x67, 0x8d, 0xb1,
-	0x27, 0x27, 0xc1, 0xa0, 0x31, 0xe4, 0xb3, 0x66, 0xf4, 0x8f, 0x86, 0xf0, 0xe7, 0x43, 0xe1, 0x1e,
-	0x36, 0x55, 0xdd, 0x07, 0xd2, 0x9b, 0x36, 0xe3, 0x06, 0x30, 0xd8, 0xd6, 0x44, 0xb7, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xa6, 0x69, 0x10, 0x33, 0xe6, 0x18, 0x00, 0x00,
+	0xb6, 0x66, 0xd4, 0x77, 0x6d, 0xbc, 0x64, 0x24, 0x36, 0x4a, 0x32, 0x4e, 0x07, 0xb7, 0x21, 0xe7,
+	0x31, 0x97, 0xce, 0xcd, 0x9c, 0x86, 0xdd, 0x3e, 0x09, 0x6b, 0x77, 0x1b, 0x8f, 0xd4, 0xf3, 0x87,
+	0x4c, 0xfa, 0x0b, 0x12, 0x62, 0x2b, 0x8f, 0x01, 0x96, 0x42, 0x5c, 0x06, 0xe3, 0x90, 0x2e, 0xb4,
+	0x2f, 0x06, 0x51, 0x43, 0x5c, 0x87, 0xdc, 0x73, 0x67, 0x1a, 0x84, 0xde, 0x9c, 0x6d, 0x3b, 0x04,
+	0x7c, 0x9c, 0xf9, 0x21, 0xb2, 0x9e, 0xc5, 0xdb, 0xb2, 0x37, 0xdb, 0xd6, 0x07, 0xb0, 0xcd, 0x34,
+	0xde, 0x34, 0xce, 0x56, 0xdf, 0xee, 0x92, 0x08, 0x61, 0xed, 0xc5, 0xba, 0x5b, 0xa7, 0x75, 0x2f,
+	0xf5, 0xac, 0x71, 0xd3, 0x5e, 0xea, 0xb9, 0x9f, 0xc4, 0xaa, 0x77, 0x4a, 0x4f, 0x19, 0x0c, 0x67,
+	0x4c, 0xa3, 0xc4, 0x56, 0xc3, 0xb3, 0x72, 0xda, 0x72, 0x93, 0xe0, 0x5d, 0x52, 0x83, 0x0a, 0xe7,
+	0x60, 0x7d, 0x38, 0x7b, 0x24, 0x33, 0xe8, 0x58, 0x2c, 0xe1, 0xf2, 0x4c, 0x2b, 0x23, 0x1a, 0x5a,
+	0x41, 0x44, 0x0d, 0x37, 0x60, 0xb2, 0x17, 0x33, 0xa0, 0x6a, 0xd2, 0xe7, 0x81, 0xa4, 0xba, 0x26,
+	0x0b, 0x24, 0x9c, 0x58, 0x3f, 0x4f, 0xf8, 0xed, 0x5d, 0x82, 0xdf, 0xa5, 0xf6, 0x88, 0x01, 0x23,
+	0x61, 0xc0, 0xfa, 0x55, 0xaa, 0xa3, 0xb4, 0x37, 0xca, 0x8b, 0x12, 0x64, 0xc4, 0x28, 0x6a, 0x5d,
+	0x19, 0x31, 0xc2, 0xef, 0x40, 0x41, 0x04, 0xfe, 0x70, 0xe2, 0xf8, 0x63, 0x1a, 0x75, 0x92, 0xa5,
+	0x00, 0xd7, 0x60, 0xd7, 0xa5, 0x42, 0x7a, 0xcc, 0x51, 0xdd, 0xcd, 0xcc, 0x69, 0x45, 0x69, 0x11,
+	0xbe, 0x0b, 0xa5, 0xa1, 0x4f, 0x5d, 0x4f, 0xf6, 0x87, 0x8e, 0xef, 0xf6, 0x19, 0x0f, 0x9b, 0xde,
+	0xfe, 0x16, 0x29, 0x86, 0xf2, 0x07, 0x8e, 0xef, 0x1e, 0x70, 0x7c, 0x1b, 0x0a, 0xc3, 0x09, 0xfd,
+	0x45, 0x40, 0x15, 0x24, 0x1f, 0x41, 0xf2, 0xa1, 0xe8, 0x80, 0xe3, 0x26, 0xe4, 0xb9, 0xef, 0x8d,
+	0x3d, 0xe6, 0x4c, 0xcd, 0x82, 0x26, 0xe2, 0xc6, 0xe9, 0xee, 0xd4, 0x22, 0x09, 0xa8, 0x57, 0x48,
+	0xba, 0xac, 0xf5, 0xaf, 0x0c, 0x14, 0x9f, 0x52, 0x21, 0x3f, 0xa3, 0xbe, 0xf0, 0x38, 0x6b, 0xe1,
+	0x22, 0xa0, 0x79, 0x54, 0x69, 0x68, 0x8e, 0xef, 0x00, 0x72, 0x22, 0x72, 0xbf, 0xb3, 0xd4, 0x99,
+	0x5e, 0x40, 0x90, 0xa3, 0x50, 0x03, 0xd3, 0x38, 0x1f, 0x35, 0x50, 0xa8, 0x61, 0x94, 0x5c, 0x6b,
+	0x51, 0x43, 0xfc, 0x01, 0x20, 0xd7, 0xcc, 0x9d, 0x87, 0xea, 0x65, 0x5f, 0x7c, 0xf1, 0xee, 0x16,
+	0x41, 0x2e, 0x2e, 0x01, 0xa2, 0xba, 0x1f, 0xe7, 0xf6, 0xb7, 0x08, 0xa2, 0xf8, 0x2e, 0xa0, 0x91,
+	0xa6, 0x70, 0xed, 0x5a, 0x85, 0x1b, 0x61, 0x0b, 0xd0, 0xd8, 0xcc, 0x9f, 0xd3, 0x90, 0xd1, 0x58,
+	0x79, 0x3b, 0x31, 0x0b, 0xe7, 0x7b, 0x3b, 0xc1, 0xef, 0x03, 0x3a, 0x34, 0x8b, 0x6b, 0x39, 0xef,
+	0x65, 0x5f, 0x7e, 0xf1, 0x2e, 0x22, 0xe8, 0xb0, 0x97, 0x03, 0x43, 0x04, 0x33, 0xeb, 0xd7, 0xc6,
+	0x0a, 0xdd, 0xf6, 0x45, 0xe9, 0xb6, 0x37, 0xa2, 0xdb, 0xde, 0x88, 0x6e, 0x5b, 0xd1, 0x7d, 0xe7,
+	0xab, 0xe8, 0xb6, 0x2f, 0x45, 0xb4, 0xfd, 0xa6, 0x88, 0xc6, 0xb7, 0xa0, 0xc0, 0xe8, 0x71, 0x7f,
+	0xe4, 0xd1, 0xa9, 0x6b, 0xbe, 0x5d, 0x43, 0xf5, 0x2c, 0xc9, 0x33, 0x7a, 0xbc, 0xa7, 0xe6, 0x71,
+	0x14, 0x7e, 0xb7, 0x1a, 0x85, 0xf6, 0x45, 0xa3, 0xd0, 0xde, 0x28, 0x0a, 0xed, 0x8d, 0xa2, 0xd0,
+	0xde, 0x28, 0x0a, 0xed, 0x4b, 0x45, 0xa1, 0xfd, 0xc6, 0xa2, 0xf0, 0x21, 0x60, 0xc6, 0x59, 0x7f,
+	0xe8, 0x7b, 0xd2, 0x1b, 0x3a, 0xd3, 0x28, 0x1c, 0xbf, 0xd1, 0xbd, 0x8b, 0x94, 0x19, 0x67, 0x0f,
+	0xa2, 0x27, 0x2b, 0x71, 0xf9, 0x77, 0x06, 0x2a, 0x69, 0xf7, 0x1f, 0x73, 0x46, 0x9f, 0x30, 0xfa,
+	0x64, 0xf4, 0x99, 0x7a, 0x95, 0x5f, 0xd1, 0x28, 0x5d, 0x19, 0xf6, 0xff, 0xb3, 0x0d, 0xdf, 0x3d,
+	0xc9, 0xfe, 0x81, 0x7e, 0x5b, 0x8d, 0xaf, 0x08, 0xf5, 0xad, 0x65, 0x41, 0xbc, 0x77, 0x36, 0x2a,
+	0xb5, 0xa7, 0x2b, 0x52, 0x1b, 0xf8, 0x3e, 0x6c, 0x7b, 0x8c, 0x51, 0xbf, 0x65, 0x96, 0xb4, 0xf2,
+	0xfa, 0x57, 0xee, 0xac, 0xf1, 0x48, 0xe3, 0x49, 0xb4, 0x2e, 0xd1, 0x60, 0x9b, 0xd7, 0x2f, 0xa4,
+	0xc1, 0x8e, 0x34, 0xd8, 0x95, 0x3f, 0x22, 0xd8, 0x0e, 0x95, 0xa6, 0xbe, 0x93, 0x8c, 0xb5, 0xdf,
+	0x49, 0x8f, 0xd4, 0x27, 0x3f, 0xa3, 0x7e, 0x14, 0xfd, 0xf6, 0xa6, 0x1e, 0x87, 0x3f, 0xfa, 0x0f,
+	0x09, 0x35, 0x54, 0xee, 0x01, 0x2c, 0x85, 0x29, 0xe3, 0x85, 0xd8, 0xb8, 0x3e, 0x93, 0x45, 0xc6,
+	0xd5, 0xb8, 0xf2, 0xe7, 0xd8, 0x57, 0xfb, 0x14, 0xdc, 0x84, 0x9d, 0x21, 0x0f, 0x58, 0x7c, 0x48,
+	0x2c, 0x90, 0x78, 0x7a, 0x59, 0x8f, 0xed, 0xff, 0x85, 0xc7, 0x71, 0xfd, 0x7d, 0xb9, 0x5a, 0x7f,
+	0x9d, 0x6f, 0xeb, 0xef, 0x0a, 0xd5, 0x5f, 0xe7, 0x6b, 0xd7, 0x5f, 0xe7, 0x1b, 0xae, 0xbf, 0xce,
+	0xd7, 0xaa, 0x3f, 0x63, 0x6d, 0xfd, 0x7d, 0xfe, 0x7f, 0xab, 0xbf, 0xce, 0x46, 0xf5, 0x67, 0x9f,
+	0x5b, 0x7f, 0x37, 0xd3, 0x17, 0x07, 0x46, 0x74, 0x49, 0x10, 0x57, 0xe0, 0x5f, 0x11, 0x94, 0x52,
+	0xf6, 0xf6, 0x3e, 0xb9, 0xdc, 0x71, 0xe8, 0x8d, 0x1f, 0x4b, 0xe2, 0xfd, 0xfc, 0x03, 0xad, 0x7c,
+	0x4f, 0xed, 0x7d, 0xd2, 0xfa, 0x99, 0x27, 0x27, 0x0f, 0xe7, 0xd2, 0x77, 0xba, 0x6c, 0xf1, 0x8d,
+	0xee, 0xed, 0xce, 0x72, 0x6f, 0x29, 0x5c, 0x97, 0x2d, 0x12, 0x8f, 0x2e, 0xbc, 0xbb, 0xa7, 0x50,
+	0x4c, 0xaf, 0xc7, 0x75, 0xb5, 0x01, 0xb4, 0x9e, 0xbe, 0xb8, 0x03, 0x38, 0xb8, 0x18, 0x77, 0x46,
+	0x43, 0x75, 0xc0, 0x62, 0xd8, 0x01, 0xf5, 0x6c, 0x68, 0xfd, 0x05, 0x41, 0x59, 0x19, 0xfc, 0xf4,
+	0xc8, 0x75, 0x24, 0x75, 0x9f, 0xce, 0x89, 0x73, 0x8c, 0x6f, 0x03, 0x0c, 0xb8, 0xbb, 0xe8, 0x0f,
+	0x16, 0x92, 0x0a, 0x6d, 0xa3, 0x48, 0x0a, 0x4a, 0xd2, 0x53, 0x02, 0x7c, 0x17, 0xae, 0x3b, 0x81,
+	0x9c, 0xf4, 0x3d, 0x36, 0xe2, 0x11, 0x26, 0xa3, 0x31, 0xd7, 0x94, 0xf8, 0x11, 0x1b, 0xf1, 0x10,
+	0x57, 0x05, 0x10, 0xde, 0x98, 0x39, 0x32, 0xf0, 0xa9, 0x30, 0x8d, 0x9a, 0x51, 0x2f, 0x92, 0x94,
+	0x04, 0x57, 0x61, 0x37, 0x39, 0xbb, 0xf4, 0x3f, 0xd2, 0x37, 0x06, 0x45, 0x52, 0x88, 0x4f, 0x2f,
+	0x1f, 0xe1, 0xef, 0x41, 0x69, 0xf9, 0xbc, 0x75, 0xcf, 0xee, 0x98, 0xbf, 0xcc, 0x6b, 0x4c, 0x31,
+	0xc6, 0x28, 0xa1, 0xf5, 0x27, 0x03, 0xde, 0x5a, 0xd9, 0x42, 0x8f, 0xbb, 0x0b, 0x7c, 0x0f, 0xf2,
+	0x33, 0x2a, 0x84, 0x33, 0xd6, 0x3b, 0x30, 0xd6, 0x26, 0x59, 0x82, 0x52, 0xd5, 0x3d, 0xa3, 0x33,
+	0x1e, 0x57, 0xb7, 0x1a, 0x2b, 0x17, 0xa4, 0x37, 0xa3, 0x3c, 0x90, 0xfd, 0x09, 0xf5, 0xc6, 0x13,
+	0x19, 0xf1, 0x78, 0x2d, 0x92, 0xee, 0x6b, 0xa1, 0xba, 0x19, 0x09, 0x18, 0xf7, 0x5d, 0xea, 0x53,
+	0x57, 0x73, 0x9b, 0x27, 0x4b, 0x01, 0xbe, 0x03, 0x25, 0xc1, 0x67, 0xb4, 0xbf, 0x3c, 0xa8, 0xe5,
+	0xf4, 0x41, 0xad, 0xa8, 0xa4, 0x07, 0xd1, 0x56, 0xf0, 0x3e, 0xbc, 0xb7, 0x8a, 0xea, 0x9f, 0xd1,
+	0xb6, 0xff, 0x10, 0xb6, 0xed, 0x77, 0xd2, 0x2b, 0x0f, 0x4e, 0xb6, 0xf0, 0x1e, 0xbc, 0x45, 0xe7,
+	0x92, 0x32, 0x95, 0x41, 0x7d, 0xae, 0x2f, 0x9b, 0x85, 0xf9, 0xe5, 0xce, 0x39, 0x24, 0x94, 0x13,
+	0xfc, 0x93, 0x10, 0x8e, 0x9f, 0x41, 0x75, 0xc5, 0xfc, 0x19, 0x0a, 0xaf, 0x9f, 0xa3, 0xf0, 0x56,
+	0xea, 0xbd, 0xf2, 0xf0, 0x84, 0x6e, 0xeb, 0x05, 0x82, 0x1b, 0xa9, 0x80, 0x75, 0xa3, 0xa4, 0xc1,
+	0xf7, 0xa1, 0xa8, 0xb2, 0x83, 0xfa, 0x3a, 0xb3, 0xe2, 0xb0, 0xdd, 0x6e, 0x84, 0x97, 0xf3, 0x0d,
+	0x39, 0x6f, 0x44, 0x97, 0xf3, 0x8d, 0x9f, 0x6a, 0x98, 0x5a, 0x44, 0x76, 0x45, 0x32, 0x16, 0xb8,
+	0xbe, 0xbc, 0x91, 0x53, 0x25, 0x75, 0x7a, 0xe1, 0x1e, 0xa5, 0xe1, 0x4d, 0xdd, 0x4a, 0xee, 0xb5,
+	0x4d, 0x63, 0x35, 0xf7, 0xda, 0x9b, 0xe6, 0xde, 0xfb, 0x61, 0xea, 0x11, 0x7a, 0x44, 0xd5, 0x56,
+	0x3e, 0xf5, 0x98, 0xd4, 0x89, 0xc4, 0x82, 0x59, 0xe8, 0x7f, 0x96, 0xe8, 0x71, 0x6f, 0xff, 0xc5,
+	0xab, 0x2a, 0x7a, 0xf9, 0xaa, 0x8a, 0xfe, 0xf9, 0xaa, 0x8a, 0x7e, 0xfb, 0xba, 0xba, 0xf5, 0xf2,
+	0x75, 0x75, 0xeb, 0xef, 0xaf, 0xab, 0x5b, 0xcf, 0x1a, 0x63, 0x4f, 0x4e, 0x82, 0x41, 0x63, 0xc8,
+	0x67, 0xcd, 0xe8, 0xdf, 0x10, 0xe1, 0xcf, 0x87, 0xc2, 0x3d, 0x6c, 0x4a, 0x2a, 0x64, 0x20, 0xbd,
+	0x69, 0x33, 0x6e, 0x0f, 0x83, 0x6d, 0x4d, 0x74, 0xfb, 0xbf, 0x03, 0x00, 0x6b, 0x1c, 0x6f, 0x3e,
+	0x04, 0x19, 0x00, 0x00,
 }
 
 func (m *Customer1) Marshal() (dAtA []byte, err error) {
@@ -5252,6 +5261,16 @@ func (m *TestUpdatedTxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.SomeNewField != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewField))
 		i--
+		dAtA[i] = 0x28
+	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
 		dAtA[i] = 0x20
 	}
 	if m.TimeoutHeight != 0 {
@@ -6423,6 +6442,9 @@ func (m *TestUpdatedTxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovUnknonwnproto(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
 	if m.SomeNewField != 0 {
 		n += 1 + sovUnknonwnproto(uint64(m.SomeNewField))
 	}
@@ -12594,6 +12616,26 @@ func (m *TestUpdatedTxBody) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnknonwnproto
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SomeNewField", wireType)
 			}
@@ -38,6 +38,8 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *tmproto.ConsensusParams
 	eventManager  *EventManager
+	gasSchedule   storetypes.GasSchedule
+	priority      int64 // The tx priority, only relevant in CheckTx
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -58,6 +60,10 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Priority() int64             { return c.priority }
+
+// GasSchedule returns the gas configs KVStores are accessed with, by store key.
+func (c Context) GasSchedule() storetypes.GasSchedule { return c.gasSchedule }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -214,12 +220,24 @@ func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority
+func (c Context) WithPriority(p int64) Context {
+	c.priority = p
+	return c
+}
+
 // WithConsensusParams returns a Context with an updated consensus params
 func (c Context) WithConsensusParams(params *tmproto.ConsensusParams) Context {
 	c.consParams = params
 	return c
 }
 
+// WithGasSchedule returns a Context with an updated gas schedule
+func (c Context) WithGasSchedule(schedule storetypes.GasSchedule) Context {
+	c.gasSchedule = schedule
+	return c
+}
+
 // WithEventManager returns a Context with an updated event manager
 func (c Context) WithEventManager(em *EventManager) Context {
 	c.eventManager = em
@@ -246,12 +264,12 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.gasSchedule.GasConfig(key, storetypes.KVGasConfig()))
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.gasSchedule.GasConfig(key, storetypes.TransientGasConfig()))
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -11,6 +11,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -51,6 +52,28 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Equal(v2, store.Get(k2))
 }
 
+func (s *contextTestSuite) TestGasSchedule() {
+	key := types.NewKVStoreKey(s.T().Name())
+	tkey := types.NewTransientStoreKey("transient_" + s.T().Name())
+	ctx := testutil.DefaultContext(key, tkey)
+
+	// stores without a gas config in the schedule use the default gas configs
+	ctx = ctx.WithGasMeter(types.NewInfiniteGasMeter())
+	ctx.KVStore(key).Has([]byte("key"))
+	ctx.TransientStore(tkey).Has([]byte("key"))
+	s.Require().Equal(storetypes.KVGasConfig().HasCost+storetypes.TransientGasConfig().HasCost, ctx.GasMeter().GasConsumed())
+
+	schedule := storetypes.GasSchedule{Stores: []storetypes.StoreGasConfig{
+		{StoreKey: key.Name(), GasConfig: storetypes.GasConfig{HasCost: 1}},
+		{StoreKey: tkey.Name(), GasConfig: storetypes.GasConfig{HasCost: 2}},
+	}}
+	ctx = ctx.WithGasSchedule(schedule).WithGasMeter(types.NewInfiniteGasMeter())
+	s.Require().Equal(schedule, ctx.GasSchedule())
+	ctx.KVStore(key).Has([]byte("key"))
+	ctx.TransientStore(tkey).Has([]byte("key"))
+	s.Require().Equal(types.Gas(3), ctx.GasMeter().GasConsumed())
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := types.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, types.NewTransientStoreKey("transient_"+s.T().Name()))
@@ -217,6 +217,7 @@ var (
 	AttributeKeyAccountSequence = "acc_seq"
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
+	AttributeKeyFeeRefund       = "fee_refund"
 
 	EventTypeMessage = "message"
 
@@ -0,0 +1,66 @@
+package mempool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Mempool defines the app-side mempool, which holds the txs which passed
+// CheckTx and orders them for block proposals. Tendermint still gossips and
+// keeps its own mempool; the app-side mempool only decides which of these txs
+// the app proposes, and in which order.
+type Mempool interface {
+	// Insert inserts a tx which passed CheckTx into the mempool, with the
+	// priority and tx bytes of the given context. It returns an error if the
+	// tx is not accepted, e.g. because the mempool is full.
+	Insert(ctx sdk.Context, tx sdk.Tx) error
+
+	// Select returns an iterator over the txs of the mempool, in the order
+	// they should be proposed, or nil if the mempool is empty.
+	Select(ctx sdk.Context) Iterator
+
+	// CountTx returns the number of txs in the mempool.
+	CountTx() int
+
+	// Remove removes a tx from the mempool. It returns an ErrNotFound error if
+	// the tx is not in the mempool.
+	Remove(tx sdk.Tx) error
+
+	// Lookup returns the bytes of the tx of a sender with a nonce, and whether
+	// the mempool has one.
+	Lookup(sender string, nonce uint64) ([]byte, bool)
+}
+
+// Iterator iterates over the txs selected from a mempool.
+type Iterator interface {
+	// Next returns the iterator at the next tx, or nil if there is none.
+	Next() Iterator
+
+	// Tx returns the current tx.
+	Tx() sdk.Tx
+
+	// TxBytes returns the bytes of the current tx, as they were checked.
+	TxBytes() []byte
+}
+
+// SenderNonce returns the sender of a tx, its first signer, and its nonce,
+// the sequence of the first signer's signature.
+func SenderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return "", 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a SigVerifiableTx")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return "", 0, sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "tx must have at least one signer")
+	}
+
+	return signers[0].String(), sigs[0].Sequence, nil
+}
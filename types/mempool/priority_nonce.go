@@ -0,0 +1,307 @@
+package mempool
+
+import (
+	"container/heap"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Mempool = (*PriorityNonceMempool)(nil)
+
+// Lane is a set of msg types. Txs whose msgs all have a type of a lane are in
+// that lane, and are selected before the txs of the lanes after it.
+type Lane struct {
+	Name        string
+	MsgTypeURLs []string
+}
+
+// PriorityNonceConfig configures a PriorityNonceMempool.
+type PriorityNonceConfig struct {
+	// MaxTx is the maximum number of txs in the mempool. Zero means no limit.
+	MaxTx int
+
+	// Lanes are the lanes of txs, from the first selected to the last. Txs
+	// which are in no lane are selected after the txs of all lanes.
+	Lanes []Lane
+
+	// PriceBump is the percentage by which the priority of a tx must exceed
+	// the priority of the tx it replaces, which has the same sender and nonce.
+	PriceBump uint64
+}
+
+// DefaultPriorityNonceConfig returns a config with no tx limit and no lanes,
+// which requires a 10% priority bump to replace a tx.
+func DefaultPriorityNonceConfig() PriorityNonceConfig {
+	return PriorityNonceConfig{
+		MaxTx:     0,
+		Lanes:     nil,
+		PriceBump: 10,
+	}
+}
+
+// PriorityNonceMempool is a mempool which selects the txs of each sender in
+// nonce order, and otherwise selects txs by lane, then by priority, then by
+// insertion order. A tx with the same sender and nonce as a tx in the mempool
+// replaces it if its priority is higher by at least the configured price bump.
+type PriorityNonceMempool struct {
+	mtx sync.Mutex
+
+	cfg     PriorityNonceConfig
+	lanes   []map[string]struct{}
+	senders map[string]map[uint64]*txEntry
+	count   int
+	order   uint64
+}
+
+// txEntry is a tx of a PriorityNonceMempool.
+type txEntry struct {
+	tx       sdk.Tx
+	txBytes  []byte
+	sender   string
+	nonce    uint64
+	priority int64
+	lane     int
+	order    uint64
+}
+
+// NewPriorityNonceMempool returns a new, empty PriorityNonceMempool.
+func NewPriorityNonceMempool(cfg PriorityNonceConfig) *PriorityNonceMempool {
+	lanes := make([]map[string]struct{}, len(cfg.Lanes))
+	for i, lane := range cfg.Lanes {
+		lanes[i] = make(map[string]struct{}, len(lane.MsgTypeURLs))
+		for _, typeURL := range lane.MsgTypeURLs {
+			lanes[i][typeURL] = struct{}{}
+		}
+	}
+
+	return &PriorityNonceMempool{
+		cfg:     cfg,
+		lanes:   lanes,
+		senders: make(map[string]map[uint64]*txEntry),
+	}
+}
+
+// Insert implements Mempool.Insert. The tx is inserted with the priority of the
+// context.
+func (mp *PriorityNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := SenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	entry := &txEntry{
+		tx:       tx,
+		txBytes:  ctx.TxBytes(),
+		sender:   sender,
+		nonce:    nonce,
+		priority: ctx.Priority(),
+		lane:     mp.txLane(tx),
+		order:    mp.order,
+	}
+
+	nonces := mp.senders[sender]
+	if existing, ok := nonces[nonce]; ok {
+		if !mp.canReplace(existing.priority, entry.priority) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFee,
+				"tx replacement underpriced; priority %d must exceed %d by %d%%", entry.priority, existing.priority, mp.cfg.PriceBump,
+			)
+		}
+
+		nonces[nonce] = entry
+		mp.order++
+		return nil
+	}
+
+	if mp.cfg.MaxTx > 0 && mp.count >= mp.cfg.MaxTx {
+		return sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "mempool has %d txs", mp.count)
+	}
+
+	if nonces == nil {
+		nonces = make(map[uint64]*txEntry)
+		mp.senders[sender] = nonces
+	}
+	nonces[nonce] = entry
+	mp.count++
+	mp.order++
+
+	return nil
+}
+
+// Select implements Mempool.Select. The txs of each sender are selected in
+// nonce order; among the next txs of all senders, the tx of the first lane is
+// selected first, then the tx with the highest priority, then the oldest tx.
+func (mp *PriorityNonceMempool) Select(_ sdk.Context) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	// The txs of each sender, in nonce order.
+	queues := make([][]*txEntry, 0, len(mp.senders))
+	for _, nonces := range mp.senders {
+		queue := make([]*txEntry, 0, len(nonces))
+		for _, entry := range nonces {
+			queue = append(queue, entry)
+		}
+		sort.Slice(queue, func(i, j int) bool { return queue[i].nonce < queue[j].nonce })
+		queues = append(queues, queue)
+	}
+
+	heads := make(txHeap, len(queues))
+	for i, queue := range queues {
+		heads[i] = queue
+	}
+	heap.Init(&heads)
+
+	selected := make([]*txEntry, 0, mp.count)
+	for heads.Len() > 0 {
+		queue := heads[0]
+		selected = append(selected, queue[0])
+		if len(queue) == 1 {
+			heap.Pop(&heads)
+		} else {
+			heads[0] = queue[1:]
+			heap.Fix(&heads, 0)
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+
+	return &iterator{txs: selected}
+}
+
+// CountTx implements Mempool.CountTx.
+func (mp *PriorityNonceMempool) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.count
+}
+
+// Remove implements Mempool.Remove. It removes the tx with the same sender and
+// nonce as the given tx, which may be a tx it replaced.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := SenderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	nonces := mp.senders[sender]
+	if _, ok := nonces[nonce]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no tx from %s with nonce %d in mempool", sender, nonce)
+	}
+
+	delete(nonces, nonce)
+	if len(nonces) == 0 {
+		delete(mp.senders, sender)
+	}
+	mp.count--
+
+	return nil
+}
+
+// Lookup implements Mempool.Lookup.
+func (mp *PriorityNonceMempool) Lookup(sender string, nonce uint64) ([]byte, bool) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	entry, ok := mp.senders[sender][nonce]
+	if !ok {
+		return nil, false
+	}
+
+	return entry.txBytes, true
+}
+
+// txLane returns the index of the first lane which has the types of all the
+// msgs of a tx, or the number of lanes if there is none.
+func (mp *PriorityNonceMempool) txLane(tx sdk.Tx) int {
+	msgs := tx.GetMsgs()
+
+lanes:
+	for i, lane := range mp.lanes {
+		if len(msgs) == 0 {
+			break
+		}
+		for _, msg := range msgs {
+			if _, ok := lane[sdk.MsgTypeURL(msg)]; !ok {
+				continue lanes
+			}
+		}
+		return i
+	}
+
+	return len(mp.lanes)
+}
+
+// canReplace returns whether a tx with priority newPriority may replace a tx
+// with priority oldPriority.
+func (mp *PriorityNonceMempool) canReplace(oldPriority, newPriority int64) bool {
+	if newPriority <= oldPriority {
+		return false
+	}
+
+	// newPriority * 100 >= oldPriority * (100 + PriceBump)
+	bumped := sdk.NewInt(oldPriority).Mul(sdk.NewIntFromUint64(100 + mp.cfg.PriceBump))
+	return sdk.NewInt(newPriority).MulRaw(100).GTE(bumped)
+}
+
+// txHeap is a heap of the remaining txs of each sender, ordered by their next
+// tx.
+type txHeap [][]*txEntry
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	a, b := h[i][0], h[j][0]
+	switch {
+	case a.lane != b.lane:
+		return a.lane < b.lane
+	case a.priority != b.priority:
+		return a.priority > b.priority
+	default:
+		return a.order < b.order
+	}
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x interface{}) { *h = append(*h, x.([]*txEntry)) }
+
+func (h *txHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// iterator iterates over the txs selected from a PriorityNonceMempool.
+type iterator struct {
+	txs []*txEntry
+}
+
+// Next implements Iterator.Next.
+func (it *iterator) Next() Iterator {
+	if len(it.txs) <= 1 {
+		return nil
+	}
+
+	return &iterator{txs: it.txs[1:]}
+}
+
+// Tx implements Iterator.Tx.
+func (it *iterator) Tx() sdk.Tx { return it.txs[0].tx }
+
+// TxBytes implements Iterator.TxBytes.
+func (it *iterator) TxBytes() []byte { return it.txs[0].txBytes }
@@ -0,0 +1,142 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// testTx is a tx with a single signer and nonce.
+type testTx struct {
+	id     int
+	sender sdk.AccAddress
+	nonce  uint64
+	msgs   []sdk.Msg
+}
+
+var _ signing.SigVerifiableTx = testTx{}
+
+func (tx testTx) GetMsgs() []sdk.Msg                        { return tx.msgs }
+func (tx testTx) ValidateBasic() error                      { return nil }
+func (tx testTx) GetSigners() []sdk.AccAddress              { return []sdk.AccAddress{tx.sender} }
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+func (tx testTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+
+func insert(t *testing.T, mp mempool.Mempool, tx testTx, priority int64) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger())
+	require.NoError(t, mp.Insert(ctx.WithPriority(priority), tx))
+}
+
+func selectIDs(mp mempool.Mempool) []int {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger())
+	ids := []int{}
+	for it := mp.Select(ctx); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+	return ids
+}
+
+func TestPriorityNonceMempoolOrder(t *testing.T) {
+	_, _, sender1 := testdata.KeyTestPubAddr()
+	_, _, sender2 := testdata.KeyTestPubAddr()
+	_, _, sender3 := testdata.KeyTestPubAddr()
+	msg1, msg2 := testdata.NewTestMsg(sender1), testdata.NewTestMsg(sender2)
+
+	mp := mempool.NewPriorityNonceMempool(mempool.DefaultPriorityNonceConfig())
+	require.Nil(t, mp.Select(sdk.Context{}))
+
+	// sender1's txs are selected in nonce order, although the later one has a
+	// higher priority
+	insert(t, mp, testTx{id: 0, sender: sender1, nonce: 1, msgs: []sdk.Msg{msg1}}, 30)
+	insert(t, mp, testTx{id: 1, sender: sender1, nonce: 0, msgs: []sdk.Msg{msg1}}, 10)
+	insert(t, mp, testTx{id: 2, sender: sender2, nonce: 0, msgs: []sdk.Msg{msg2}}, 20)
+	// equal priorities are selected in insertion order
+	insert(t, mp, testTx{id: 3, sender: sender3, nonce: 5, msgs: []sdk.Msg{msg1}}, 10)
+
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, []int{2, 1, 0, 3}, selectIDs(mp))
+
+	// the tx with the same sender and nonce is removed
+	require.NoError(t, mp.Remove(testTx{sender: sender1, nonce: 0}))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []int{0, 2, 3}, selectIDs(mp))
+
+	err := mp.Remove(testTx{sender: sender1, nonce: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestPriorityNonceMempoolLanes(t *testing.T) {
+	_, _, sender1 := testdata.KeyTestPubAddr()
+	_, _, sender2 := testdata.KeyTestPubAddr()
+	_, _, sender3 := testdata.KeyTestPubAddr()
+	fastMsg, slowMsg := testdata.NewTestMsg(sender1), &testdata.MsgCreateDog{Dog: &testdata.Dog{}}
+
+	cfg := mempool.DefaultPriorityNonceConfig()
+	cfg.Lanes = []mempool.Lane{{Name: "fast", MsgTypeURLs: []string{sdk.MsgTypeURL(fastMsg)}}}
+	mp := mempool.NewPriorityNonceMempool(cfg)
+
+	insert(t, mp, testTx{id: 0, sender: sender1, nonce: 0, msgs: []sdk.Msg{slowMsg}}, 100)
+	insert(t, mp, testTx{id: 1, sender: sender2, nonce: 0, msgs: []sdk.Msg{fastMsg}}, 1)
+	// a tx is only in a lane if all its msgs are
+	insert(t, mp, testTx{id: 2, sender: sender3, nonce: 0, msgs: []sdk.Msg{fastMsg, slowMsg}}, 50)
+	// the lane does not reorder the txs of a sender
+	insert(t, mp, testTx{id: 3, sender: sender1, nonce: 1, msgs: []sdk.Msg{fastMsg}}, 1)
+
+	require.Equal(t, []int{1, 0, 3, 2}, selectIDs(mp))
+}
+
+func TestPriorityNonceMempoolReplacement(t *testing.T) {
+	_, _, sender := testdata.KeyTestPubAddr()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger())
+	mp := mempool.NewPriorityNonceMempool(mempool.DefaultPriorityNonceConfig())
+
+	insert(t, mp, testTx{id: 0, sender: sender, nonce: 0}, 100)
+
+	// the priority must be higher by 10%
+	err := mp.Insert(ctx.WithPriority(100), testTx{id: 1, sender: sender, nonce: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	err = mp.Insert(ctx.WithPriority(109), testTx{id: 1, sender: sender, nonce: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.Equal(t, []int{0}, selectIDs(mp))
+
+	ctx = ctx.WithTxBytes([]byte("replacement"))
+	require.NoError(t, mp.Insert(ctx.WithPriority(110), testTx{id: 1, sender: sender, nonce: 0}))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []int{1}, selectIDs(mp))
+
+	// the bytes of the replacement are looked up by sender and nonce
+	txBytes, ok := mp.Lookup(sender.String(), 0)
+	require.True(t, ok)
+	require.Equal(t, []byte("replacement"), txBytes)
+	_, ok = mp.Lookup(sender.String(), 1)
+	require.False(t, ok)
+}
+
+func TestPriorityNonceMempoolMaxTx(t *testing.T) {
+	_, _, sender := testdata.KeyTestPubAddr()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, log.NewNopLogger())
+
+	cfg := mempool.DefaultPriorityNonceConfig()
+	cfg.MaxTx = 1
+	mp := mempool.NewPriorityNonceMempool(cfg)
+
+	insert(t, mp, testTx{id: 0, sender: sender, nonce: 0}, 1)
+	err := mp.Insert(ctx, testTx{id: 1, sender: sender, nonce: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrMempoolIsFull)
+
+	// a full mempool still replaces txs
+	insert(t, mp, testTx{id: 2, sender: sender, nonce: 0}, 2)
+	require.Equal(t, []int{2}, selectIDs(mp))
+}
@@ -6,6 +6,7 @@ package tx
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/store/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -402,6 +403,10 @@ type SimulateResponse struct {
 	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
 	// result is the result of the simulation.
 	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// accesses are the keys of each store accessed by the simulation, ordered by store key.
+	//
+	// Since: cosmos-sdk 0.46
+	Accesses []*types1.StoreAccesses `protobuf:"bytes,3,rep,name=accesses,proto3" json:"accesses,omitempty"`
 }
 
 func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
@@ -451,6 +456,13 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+func (m *SimulateResponse) GetAccesses() []*types1.StoreAccesses {
+	if m != nil {
+		return m.Accesses
+	}
+	return nil
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -582,59 +594,62 @@ func init() {
 }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x3b, 0x65, 0x93, 0xbe, 0x64, 0x4b, 0x3a, 0xbb, 0x14, 0x93, 0x82, 0x37, 0x75, 0xc9,
-	0x36, 0x44, 0xc2, 0x56, 0x03, 0x48, 0x08, 0x71, 0x89, 0x93, 0x74, 0x59, 0x41, 0x9b, 0x6a, 0xb2,
-	0x08, 0x15, 0x21, 0x45, 0x4e, 0x32, 0xf5, 0x5a, 0x6c, 0x3c, 0x59, 0xcf, 0x64, 0xe5, 0xa8, 0xad,
-	0x90, 0x38, 0x72, 0x42, 0xe2, 0x67, 0xf0, 0x27, 0x38, 0x72, 0x5c, 0x89, 0x0b, 0x47, 0xb4, 0xe1,
-	0x47, 0x70, 0x44, 0x1e, 0x4f, 0x12, 0x27, 0xeb, 0x74, 0x11, 0xa7, 0xbc, 0xc9, 0x7c, 0xef, 0x7b,
-	0xdf, 0xfb, 0xe6, 0xcd, 0x18, 0xf6, 0x07, 0x94, 0x8d, 0x28, 0xb3, 0x78, 0x68, 0x9d, 0x3f, 0xec,
-	0x13, 0xee, 0x3c, 0xb4, 0x18, 0x09, 0xce, 0xbd, 0x01, 0x31, 0xc7, 0x01, 0xe5, 0x14, 0xdd, 0x8e,
-	0x01, 0x26, 0x0f, 0x4d, 0x09, 0x28, 0xbd, 0xeb, 0x52, 0xea, 0x9e, 0x12, 0xcb, 0x19, 0x7b, 0x96,
-	0xe3, 0xfb, 0x94, 0x3b, 0xdc, 0xa3, 0x3e, 0x8b, 0x13, 0x4a, 0xf7, 0x25, 0x63, 0xdf, 0x61, 0xc4,
-	0x72, 0xfa, 0x03, 0x6f, 0x41, 0x1c, 0x2d, 0x24, 0xa8, 0x74, 0xb5, 0x2c, 0x0f, 0xe5, 0xde, 0x9e,
-	0x4b, 0x5d, 0x2a, 0x42, 0x2b, 0x8a, 0xe4, 0xbf, 0xb5, 0x24, 0xed, 0xd9, 0x84, 0x04, 0xd3, 0x45,
-	0xe6, 0xd8, 0x71, 0x3d, 0x5f, 0x68, 0x88, 0xb1, 0xc6, 0xaf, 0x0a, 0xa0, 0x43, 0xc2, 0x8f, 0x43,
-	0xd6, 0x3e, 0x27, 0x3e, 0xc7, 0xe4, 0x6c, 0x42, 0x18, 0x47, 0x77, 0x60, 0x9b, 0x44, 0x6b, 0xa6,
-	0x29, 0xe5, 0x4c, 0xf5, 0x26, 0x96, 0x2b, 0xf4, 0x08, 0x60, 0x49, 0xa1, 0xa9, 0x65, 0xa5, 0x9a,
-	0xaf, 0x1f, 0x98, 0xb2, 0xef, 0xa8, 0x9e, 0x29, 0xea, 0xcd, 0xfb, 0x37, 0x9f, 0x3a, 0x2e, 0x91,
-	0x9c, 0x38, 0x91, 0x89, 0x3e, 0x81, 0x1c, 0x0d, 0x86, 0x24, 0xe8, 0xf5, 0xa7, 0x5a, 0xa6, 0xac,
-	0x54, 0x6f, 0xd5, 0x4b, 0xe6, 0x15, 0xf7, 0xcc, 0x4e, 0x04, 0xb1, 0xa7, 0x38, 0x4b, 0xe3, 0xc0,
-	0xb8, 0x50, 0x60, 0x77, 0x45, 0x2d, 0x1b, 0x53, 0x9f, 0x11, 0xf4, 0x00, 0x32, 0x3c, 0x8c, 0xb5,
-	0xe6, 0xeb, 0x6f, 0xa5, 0x30, 0x1d, 0x87, 0x38, 0x42, 0xa0, 0x43, 0x28, 0xf0, 0xb0, 0x17, 0xc8,
-	0x3c, 0xa6, 0xa9, 0x22, 0xe3, 0xfd, 0x95, 0x0e, 0x84, 0xf7, 0x89, 0x44, 0x09, 0xc6, 0x79, 0xbe,
-	0x88, 0x23, 0xa2, 0xa4, 0x11, 0x19, 0x61, 0xc4, 0x83, 0x6b, 0x8d, 0x90, 0x4c, 0x89, 0x54, 0x83,
-	0x00, 0xb2, 0x03, 0xea, 0x0c, 0x07, 0x0e, 0xe3, 0x51, 0xb1, 0xd8, 0xff, 0x77, 0x20, 0xc7, 0xc3,
-	0x5e, 0x7f, 0xca, 0x49, 0xd4, 0x95, 0x52, 0x2d, 0xe0, 0x2c, 0x0f, 0xed, 0x68, 0x89, 0x3e, 0x86,
-	0x1b, 0x23, 0x3a, 0x24, 0xc2, 0xfc, 0x5b, 0xf5, 0x72, 0x4a, 0xb3, 0x0b, 0xbe, 0xc7, 0x74, 0x48,
-	0xb0, 0x40, 0x1b, 0xdf, 0xc1, 0xee, 0x4a, 0x19, 0x69, 0x5c, 0x1b, 0xf2, 0x09, 0x3f, 0x44, 0xa9,
-	0xff, 0x6a, 0x07, 0x2c, 0xed, 0x30, 0xbe, 0x81, 0x37, 0xbb, 0xde, 0x68, 0x72, 0xea, 0xf0, 0xf9,
-	0x69, 0xa3, 0x0f, 0x40, 0xe5, 0xa1, 0x24, 0x4c, 0x3f, 0x11, 0x5b, 0xd5, 0x14, 0xac, 0xf2, 0x70,
-	0xa5, 0x59, 0x75, 0xa5, 0x59, 0xe3, 0x27, 0x05, 0x8a, 0x4b, 0x66, 0x29, 0xfa, 0x73, 0xc8, 0xb9,
-	0x0e, 0xeb, 0x79, 0xfe, 0x73, 0x2a, 0x0b, 0xdc, 0xdb, 0xac, 0xf8, 0xd0, 0x61, 0x47, 0xfe, 0x73,
-	0x8a, 0xb3, 0x6e, 0x1c, 0xa0, 0x4f, 0x61, 0x3b, 0x20, 0x6c, 0x72, 0xca, 0xe5, 0xf8, 0x96, 0x37,
-	0xe7, 0x62, 0x81, 0xc3, 0x12, 0x6f, 0x18, 0x50, 0x10, 0xc3, 0x37, 0x6f, 0x11, 0xc1, 0x8d, 0x13,
-	0x87, 0x9d, 0x08, 0x0d, 0x37, 0xb1, 0x88, 0x8d, 0x57, 0xb0, 0x23, 0x31, 0x52, 0x6c, 0xe5, 0x5a,
-	0x1f, 0x84, 0x07, 0x6b, 0x07, 0xa1, 0xfe, 0xbf, 0x83, 0xa8, 0x7d, 0x01, 0x59, 0x79, 0x69, 0x90,
-	0x06, 0x7b, 0x1d, 0xdc, 0x6a, 0xe3, 0x9e, 0xfd, 0xac, 0xf7, 0xf5, 0x93, 0xee, 0xd3, 0x76, 0xf3,
-	0xe8, 0xd1, 0x51, 0xbb, 0x55, 0xdc, 0x42, 0x45, 0x28, 0x2c, 0x76, 0x1a, 0xdd, 0x66, 0x51, 0x41,
-	0xb7, 0x61, 0x67, 0xf1, 0x4f, 0xab, 0xdd, 0x6d, 0x16, 0xd5, 0xda, 0x4b, 0xd8, 0x59, 0x99, 0x23,
-	0xa4, 0x43, 0xc9, 0xc6, 0x9d, 0x46, 0xab, 0xd9, 0xe8, 0x1e, 0xf7, 0x1e, 0x77, 0x5a, 0xed, 0x35,
-	0x56, 0x0d, 0xf6, 0xd6, 0xf6, 0xed, 0xaf, 0x3a, 0xcd, 0x2f, 0x8b, 0x0a, 0x7a, 0x1b, 0x76, 0xd7,
-	0x76, 0xba, 0xcf, 0x9e, 0x34, 0x8b, 0x6a, 0x4a, 0x4a, 0x43, 0xec, 0x64, 0xea, 0xff, 0x64, 0x20,
-	0xdb, 0x8d, 0x1f, 0x57, 0xf4, 0x02, 0x72, 0xf3, 0x11, 0x40, 0x46, 0x8a, 0x83, 0x6b, 0x93, 0x57,
-	0xba, 0xff, 0x5a, 0x8c, 0x9c, 0xd8, 0x83, 0x1f, 0xff, 0xf8, 0xfb, 0x17, 0xb5, 0x6c, 0xdc, 0xb5,
-	0x52, 0x5e, 0x75, 0x09, 0xfe, 0x4c, 0xa9, 0xa1, 0x33, 0x78, 0x43, 0x9c, 0x27, 0xda, 0x4f, 0x61,
-	0x4d, 0x4e, 0x43, 0xa9, 0xbc, 0x19, 0x20, 0x6b, 0x56, 0x44, 0xcd, 0x7d, 0xf4, 0x9e, 0x95, 0xf6,
-	0xa4, 0x33, 0xeb, 0x45, 0x34, 0x41, 0xaf, 0xd0, 0x0f, 0x90, 0x4f, 0x5c, 0x55, 0x54, 0x79, 0xdd,
-	0x0d, 0x5f, 0x96, 0x3f, 0xb8, 0x0e, 0x26, 0x45, 0xdc, 0x13, 0x22, 0xee, 0x1a, 0x77, 0xd2, 0x45,
-	0x44, 0x3d, 0xbf, 0x84, 0x7c, 0xe2, 0x91, 0x4d, 0x15, 0x70, 0xf5, 0x93, 0x91, 0x2a, 0x20, 0xe5,
-	0xad, 0x36, 0x74, 0x21, 0x40, 0x43, 0x1b, 0x04, 0xd8, 0xcd, 0xdf, 0x2f, 0x75, 0xe5, 0xe2, 0x52,
-	0x57, 0xfe, 0xba, 0xd4, 0x95, 0x9f, 0x67, 0xfa, 0xd6, 0x6f, 0x33, 0x5d, 0xb9, 0x98, 0xe9, 0x5b,
-	0x7f, 0xce, 0xf4, 0xad, 0x6f, 0x2b, 0xae, 0xc7, 0x4f, 0x26, 0x7d, 0x73, 0x40, 0x47, 0xf3, 0xfc,
-	0xf8, 0xe7, 0x43, 0x36, 0xfc, 0xde, 0xe2, 0xd3, 0x31, 0x89, 0x08, 0xfb, 0xdb, 0xe2, 0xeb, 0xf6,
-	0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x86, 0xcd, 0x5c, 0xb4, 0x07, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x61, 0x6f, 0xdb, 0x44,
+	0x18, 0xae, 0x9d, 0xd1, 0x64, 0x6f, 0xda, 0x91, 0x5d, 0xcb, 0x30, 0x1e, 0xb8, 0x99, 0x47, 0xbb,
+	0x50, 0x09, 0x5b, 0x0b, 0x20, 0x21, 0xc4, 0x97, 0x38, 0xc9, 0x4a, 0x05, 0x5b, 0xa6, 0x73, 0x11,
+	0x1a, 0x42, 0x8a, 0x9c, 0xe4, 0xe6, 0x5a, 0xb4, 0xbe, 0xd4, 0x77, 0xa9, 0x1c, 0x6d, 0x13, 0x12,
+	0xbf, 0x00, 0x89, 0x9f, 0xc1, 0x9f, 0xe0, 0x23, 0x1f, 0x2b, 0xf1, 0x85, 0x8f, 0xa8, 0xe5, 0x47,
+	0xf0, 0x11, 0xf9, 0x72, 0x71, 0x9c, 0xd4, 0x59, 0xd0, 0x3e, 0xe5, 0x2e, 0xf7, 0xbc, 0xcf, 0xfb,
+	0xbc, 0xcf, 0xfb, 0x9e, 0x0f, 0x76, 0xfa, 0x94, 0x9d, 0x52, 0x66, 0xf3, 0xd8, 0x3e, 0x7f, 0xd8,
+	0x23, 0xdc, 0x7b, 0x68, 0x33, 0x12, 0x9d, 0x07, 0x7d, 0x62, 0x0d, 0x23, 0xca, 0x29, 0xba, 0x3d,
+	0x01, 0x58, 0x3c, 0xb6, 0x24, 0x40, 0x7f, 0xdf, 0xa7, 0xd4, 0x3f, 0x21, 0xb6, 0x37, 0x0c, 0x6c,
+	0x2f, 0x0c, 0x29, 0xf7, 0x78, 0x40, 0x43, 0x36, 0x09, 0xd0, 0xef, 0x4b, 0xc6, 0x9e, 0xc7, 0x88,
+	0xed, 0xf5, 0xfa, 0x41, 0x4a, 0x9c, 0x6c, 0x24, 0x48, 0xbf, 0x9e, 0x96, 0xc7, 0xf2, 0x6c, 0xdb,
+	0xa7, 0x3e, 0x15, 0x4b, 0x3b, 0x59, 0xc9, 0x7f, 0xf7, 0xb3, 0xb4, 0x67, 0x23, 0x12, 0x8d, 0xd3,
+	0xc8, 0xa1, 0xe7, 0x07, 0xa1, 0xd0, 0x20, 0xb1, 0xb5, 0x2c, 0x96, 0x71, 0x1a, 0x91, 0x99, 0x86,
+	0x7e, 0x9f, 0x30, 0x46, 0xa4, 0x58, 0xf3, 0x37, 0x05, 0xd0, 0x01, 0xe1, 0x47, 0x31, 0x6b, 0x9f,
+	0x93, 0x90, 0x63, 0x72, 0x36, 0x22, 0x8c, 0xa3, 0x3b, 0xb0, 0x4e, 0x92, 0x3d, 0xd3, 0x94, 0x6a,
+	0xa1, 0x76, 0x13, 0xcb, 0x1d, 0x7a, 0x04, 0x30, 0x4b, 0xa6, 0xa9, 0x55, 0xa5, 0x56, 0xae, 0xef,
+	0x59, 0xd2, 0xa1, 0x24, 0x9b, 0x25, 0x94, 0x4d, 0x9d, 0xb2, 0x9e, 0x7a, 0x3e, 0x91, 0x9c, 0x38,
+	0x13, 0x89, 0x3e, 0x83, 0x12, 0x8d, 0x06, 0x24, 0xea, 0xf6, 0xc6, 0x5a, 0xa1, 0xaa, 0xd4, 0x6e,
+	0xd5, 0x75, 0xeb, 0x9a, 0xcf, 0x56, 0x27, 0x81, 0x38, 0x63, 0x5c, 0xa4, 0x93, 0x85, 0x79, 0xa1,
+	0xc0, 0xd6, 0x9c, 0x5a, 0x36, 0xa4, 0x21, 0x23, 0xe8, 0x01, 0x14, 0x78, 0x3c, 0xd1, 0x5a, 0xae,
+	0xbf, 0x93, 0xc3, 0x74, 0x14, 0xe3, 0x04, 0x81, 0x0e, 0x60, 0x83, 0xc7, 0xdd, 0x48, 0xc6, 0x31,
+	0x4d, 0x15, 0x11, 0x1f, 0xce, 0x55, 0x20, 0xba, 0x94, 0x09, 0x94, 0x60, 0x5c, 0xe6, 0xe9, 0x3a,
+	0x21, 0xca, 0x1a, 0x51, 0x10, 0x46, 0x3c, 0x58, 0x69, 0x84, 0x64, 0xca, 0x84, 0x9a, 0x04, 0x90,
+	0x13, 0x51, 0x6f, 0xd0, 0xf7, 0x18, 0x3f, 0x8a, 0xa5, 0x57, 0xe8, 0x3d, 0x28, 0xf1, 0xb8, 0xdb,
+	0x1b, 0x73, 0x92, 0x54, 0xa5, 0xd4, 0x36, 0x70, 0x91, 0xc7, 0x4e, 0xb2, 0x45, 0x9f, 0xc2, 0x8d,
+	0x53, 0x3a, 0x20, 0xc2, 0xfc, 0x5b, 0xf5, 0x6a, 0x4e, 0xb1, 0x29, 0xdf, 0x63, 0x3a, 0x20, 0x58,
+	0xa0, 0xcd, 0x1f, 0x60, 0x6b, 0x2e, 0x8d, 0x34, 0xae, 0x0d, 0xe5, 0x8c, 0x1f, 0x22, 0xd5, 0xff,
+	0xb5, 0x03, 0x66, 0x76, 0x98, 0xdf, 0xc1, 0xdb, 0x6e, 0x70, 0x3a, 0x3a, 0xf1, 0xf8, 0xb4, 0xdb,
+	0xe8, 0x23, 0x50, 0x79, 0x2c, 0x09, 0xf3, 0x3b, 0xe2, 0xa8, 0x9a, 0x82, 0x55, 0x1e, 0xcf, 0x15,
+	0xab, 0xce, 0x15, 0x9b, 0x34, 0xbc, 0x32, 0x63, 0x96, 0xa2, 0xbf, 0x84, 0x92, 0xef, 0xb1, 0x6e,
+	0x10, 0x3e, 0xa7, 0x32, 0xc1, 0xbd, 0xe5, 0x8a, 0x0f, 0x3c, 0x76, 0x18, 0x3e, 0xa7, 0xb8, 0xe8,
+	0x4f, 0x16, 0xe8, 0x73, 0x58, 0x8f, 0x08, 0x1b, 0x9d, 0x70, 0x39, 0xbe, 0xd5, 0xe5, 0xb1, 0x58,
+	0xe0, 0xb0, 0xc4, 0xa3, 0x16, 0x94, 0xa6, 0xb7, 0x47, 0x2b, 0x88, 0xc1, 0xa9, 0xcd, 0xc5, 0x8a,
+	0x8b, 0x96, 0x06, 0xbb, 0xc9, 0xae, 0x21, 0xf1, 0x38, 0x8d, 0x34, 0x4d, 0xd8, 0x10, 0x23, 0x3c,
+	0x35, 0x0a, 0xc1, 0x8d, 0x63, 0x8f, 0x1d, 0x8b, 0x4a, 0x6e, 0x62, 0xb1, 0x36, 0x5f, 0xc1, 0xa6,
+	0xc4, 0xc8, 0x92, 0x77, 0x57, 0xba, 0x29, 0x9c, 0x5c, 0x68, 0xa7, 0xfa, 0x66, 0xed, 0xdc, 0xff,
+	0x0a, 0x8a, 0xf2, 0xea, 0x21, 0x0d, 0xb6, 0x3b, 0xb8, 0xd5, 0xc6, 0x5d, 0xe7, 0x59, 0xf7, 0xdb,
+	0x27, 0xee, 0xd3, 0x76, 0xf3, 0xf0, 0xd1, 0x61, 0xbb, 0x55, 0x59, 0x43, 0x15, 0xd8, 0x48, 0x4f,
+	0x1a, 0x6e, 0xb3, 0xa2, 0xa0, 0xdb, 0xb0, 0x99, 0xfe, 0xd3, 0x6a, 0xbb, 0xcd, 0x8a, 0xba, 0xff,
+	0x12, 0x36, 0xe7, 0xa6, 0x11, 0x19, 0xa0, 0x3b, 0xb8, 0xd3, 0x68, 0x35, 0x1b, 0xee, 0x51, 0xf7,
+	0x71, 0xa7, 0xd5, 0x5e, 0x60, 0xd5, 0x60, 0x7b, 0xe1, 0xdc, 0xf9, 0xa6, 0xd3, 0xfc, 0xba, 0xa2,
+	0xa0, 0x77, 0x61, 0x6b, 0xe1, 0xc4, 0x7d, 0xf6, 0xa4, 0x59, 0x51, 0x73, 0x42, 0x1a, 0xe2, 0xa4,
+	0x50, 0xff, 0xb7, 0x00, 0x45, 0x77, 0xf2, 0x31, 0x47, 0x2f, 0xa0, 0x34, 0x1d, 0x24, 0x64, 0xe6,
+	0x38, 0xb8, 0x30, 0xbf, 0xfa, 0xfd, 0xd7, 0x62, 0xe4, 0xdc, 0xef, 0xfd, 0xfc, 0xe7, 0x3f, 0xbf,
+	0xaa, 0x55, 0xf3, 0xae, 0x9d, 0xf3, 0x8a, 0x48, 0xf0, 0x17, 0xca, 0x3e, 0x3a, 0x83, 0xb7, 0x44,
+	0x3f, 0xd1, 0x4e, 0x0e, 0x6b, 0x76, 0x1a, 0xf4, 0xea, 0x72, 0x80, 0xcc, 0xb9, 0x2b, 0x72, 0xee,
+	0xa0, 0x0f, 0xec, 0xbc, 0x27, 0x84, 0xd9, 0x2f, 0x92, 0x09, 0x7a, 0x85, 0x7e, 0x82, 0x72, 0xe6,
+	0xc2, 0xa3, 0xdd, 0xd7, 0x7d, 0x27, 0x66, 0xe9, 0xf7, 0x56, 0xc1, 0xa4, 0x88, 0x7b, 0x42, 0xc4,
+	0x5d, 0xf3, 0x4e, 0xbe, 0x88, 0xa4, 0xe6, 0x97, 0x50, 0xce, 0x7c, 0xaa, 0x73, 0x05, 0x5c, 0x7f,
+	0x78, 0xf4, 0xbd, 0x55, 0x30, 0x29, 0xc0, 0x10, 0x02, 0x34, 0xb4, 0x44, 0x80, 0xd3, 0xfc, 0xe3,
+	0xd2, 0x50, 0x2e, 0x2e, 0x0d, 0xe5, 0xef, 0x4b, 0x43, 0xf9, 0xe5, 0xca, 0x58, 0xfb, 0xfd, 0xca,
+	0x50, 0x2e, 0xae, 0x8c, 0xb5, 0xbf, 0xae, 0x8c, 0xb5, 0xef, 0x77, 0xfd, 0x80, 0x1f, 0x8f, 0x7a,
+	0x56, 0x9f, 0x9e, 0x4e, 0xe3, 0x27, 0x3f, 0x1f, 0xb3, 0xc1, 0x8f, 0x36, 0x1f, 0x0f, 0x49, 0x42,
+	0xd8, 0x5b, 0x17, 0x6f, 0xe4, 0x27, 0xff, 0x0d, 0x00, 0x70, 0x1d, 0x1f, 0x37, 0x24, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1077,6 +1092,20 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Accesses) > 0 {
+		for iNdEx := len(m.Accesses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accesses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Result != nil {
 		{
 			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
@@ -1299,6 +1328,12 @@ func (m *SimulateResponse) Size() (n int) {
 		l = m.Result.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.Accesses) > 0 {
+		for _, e := range m.Accesses {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2039,6 +2074,40 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, &types1.StoreAccesses{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -67,6 +67,15 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithUnordered extends the TxWithTimeoutHeight interface by allowing a
+	// transaction to be unordered, i.e. protected against replay by its hash
+	// until its timeout height instead of by the sequence of its signers.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
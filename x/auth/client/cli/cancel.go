@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetCancelCommand returns the tx cancel command.
+func GetCancelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [sequence]",
+		Short: "Cancel a pending transaction by replacing it with a no-op transaction",
+		Long: strings.TrimSpace(`Cancel a pending transaction of the --from account by
+broadcasting a transaction with the same sequence, which sends 1 unit of the
+fee denom from the account to itself. If no [sequence] is given, the transaction
+with the sequence of the account, i.e. its oldest pending transaction, is
+cancelled.
+
+The pending transaction is only replaced if the node runs an app-side mempool
+which accepts the replacement, so the --fees must be higher than the fees of the
+pending transaction by the price bump of the mempool.
+
+$ <appd> tx cancel 5 --from mykey --fees 2000stake
+`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				if _, err := strconv.ParseUint(args[0], 10, 64); err != nil {
+					return fmt.Errorf("invalid sequence %s: %w", args[0], err)
+				}
+				if err := cmd.Flags().Set(flags.FlagSequence, args[0]); err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feesStr, _ := cmd.Flags().GetString(flags.FlagFees)
+			fees, err := sdk.ParseCoinsNormalized(feesStr)
+			if err != nil {
+				return err
+			}
+			if fees.Empty() {
+				return errors.New("--fees is required to replace a pending transaction")
+			}
+
+			from := clientCtx.GetFromAddress()
+			msg := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin(fees[0].Denom, 1)))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -115,4 +115,12 @@ func TxAuxToFeeExec(clientCtx client.Context, filename string, extraArgs ...stri
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetAuxToFeeCommand(), append(args, extraArgs...))
 }
 
+func TxCancelExec(clientCtx client.Context, from fmt.Stringer, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, from.String()),
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetCancelCommand(), append(args, extraArgs...))
+}
+
 // DONTCOVER
@@ -1791,6 +1791,47 @@ func (s *IntegrationTestSuite) TestAuxToFee() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLICancelTx() {
+	val := s.network.Validators[0]
+	_, _, addr := testdata.KeyTestPubAddr()
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	_, sequence, err := val.ClientCtx.AccountRetriever.GetAccountNumberSequence(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+
+	// a send is broadcast, and cancelled before it is in a block
+	out, err := s.createBankMsg(val, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)))
+	s.Require().NoError(err)
+	var sendRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &sendRes))
+	s.Require().Equal(uint32(0), sendRes.Code, sendRes.RawLog)
+
+	out, err = TxCancelExec(val.ClientCtx, val.Address,
+		fmt.Sprintf("%d", sequence),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 100))),
+	)
+	s.Require().NoError(err)
+	var cancelRes sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &cancelRes))
+	s.Require().Equal(uint32(0), cancelRes.Code, cancelRes.RawLog)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// the cancel used the sequence of the send, which was never executed
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.QueryTxCmd(), []string{cancelRes.TxHash, fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &cancelRes))
+	s.Require().Equal(uint32(0), cancelRes.Code, cancelRes.RawLog)
+
+	_, newSequence, err := val.ClientCtx.AccountRetriever.GetAccountNumberSequence(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+	s.Require().Equal(sequence+1, newSequence)
+	s.Require().True(s.getBalances(val.ClientCtx, addr, s.cfg.BondDenom).IsZero())
+}
+
 func (s *IntegrationTestSuite) createBankMsg(val *network.Validator, toAddr sdk.AccAddress, amount sdk.Coins, extraFlags ...string) (testutil.BufferWriter, error) {
 	flags := []string{fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns whether an unordered tx with the given hash and
+// timeout height was already executed, and has not timed out yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.UnorderedTxKey(timeoutHeight, txHash))
+}
+
+// AddUnorderedTx records the hash of an unordered tx until its timeout height,
+// so it cannot be replayed.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxKey(timeoutHeight, txHash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes the hashes of the unordered txs which
+// timed out at or before the current block height, as they cannot be
+// executed anymore.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+	iterator := store.Iterator(types.UnorderedTxKeyPrefix, types.UnorderedTxTimeoutKey(uint64(ctx.BlockHeight())+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
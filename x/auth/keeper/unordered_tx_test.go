@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnorderedTxs(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	hash1, hash2 := []byte("hash1"), []byte("hash2")
+
+	app.AccountKeeper.AddUnorderedTx(ctx, hash1, 10)
+	app.AccountKeeper.AddUnorderedTx(ctx, hash2, 11)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1, 10))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2, 11))
+	// a tx is identified by both its hash and its timeout height
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1, 11))
+
+	// txs are kept until their timeout height
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(9))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1, 10))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(10))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1, 10))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2, 11))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(20))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2, 11))
+}
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ tx.Handler = baseFeeTxHandler{}
+
+type baseFeeTxHandler struct {
+	feeMarketKeeper FeeMarketKeeper
+	next            tx.Handler
+}
+
+// BaseFeeMiddleware enforces the base fee set by the fee market: the fee of a tx
+// must cover ceil(baseFee * gasLimit) in the base fee denom, in CheckTx and
+// DeliverTx. Once the tx ran in DeliverTx, the base fee of the gas it used,
+// ceil(baseFee * gasUsed), is collected from the fee collector and burnt or sent
+// to the base fee recipient, whether the tx succeeded or not. Simulations only
+// collect the base fee of txs whose fee covers it, and are not rejected.
+// Txs of the genesis block do not pay a base fee. A nil keeper disables the
+// base fee.
+// It must be put after DeductFeeMiddleware, and before GasRefundMiddleware so
+// the base fee is collected after refunds.
+// CONTRACT: Tx must implement FeeTx interface to use baseFeeTxHandler
+func BaseFeeMiddleware(fmk FeeMarketKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		if fmk == nil {
+			return txh
+		}
+
+		return baseFeeTxHandler{
+			feeMarketKeeper: fmk,
+			next:            txh,
+		}
+	}
+}
+
+// checkBaseFee returns the gas limit of a tx, and whether its fee covers the
+// base fee of that gas.
+func (txh baseFeeTxHandler) checkBaseFee(ctx context.Context, sdkTx sdk.Tx) (uint64, error) {
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	required := txh.feeMarketKeeper.RequiredFee(sdk.UnwrapSDKContext(ctx), feeTx.GetGas())
+	if fee := feeTx.GetFee(); fee.AmountOf(required.Denom).LT(required.Amount) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee; got: %s required: %s", fee, required)
+	}
+
+	return feeTx.GetGas(), nil
+}
+
+// collectBaseFee collects the base fee of the gas used by a tx, and appends the
+// events of the collection to its response. The collection is written only if
+// it succeeds, and a failed collection is logged without failing the tx, whose
+// state changes are already written, so the base fee is then left in the fee
+// collector.
+func (txh baseFeeTxHandler) collectBaseFee(ctx context.Context, gasLimit uint64, res tx.Response) tx.Response {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasUsed := sdkCtx.GasMeter().GasConsumed()
+	if gasUsed > gasLimit {
+		gasUsed = gasLimit
+	}
+
+	// The gas used by the tx is already measured, so the collection is not metered.
+	collectCtx, writeCollect := sdkCtx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+	baseFee := txh.feeMarketKeeper.RequiredFee(collectCtx, gasUsed)
+	if err := txh.feeMarketKeeper.CollectBaseFee(collectCtx, baseFee); err != nil {
+		sdkCtx.Logger().Error("failed to collect base fee", "base_fee", baseFee, "err", err)
+		return res
+	}
+	writeCollect()
+	res.Events = append(res.Events, collectCtx.EventManager().ABCIEvents()...)
+
+	return res
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh baseFeeTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() == 0 {
+		return txh.next.CheckTx(ctx, req, checkReq)
+	}
+
+	if _, err := txh.checkBaseFee(ctx, req.Tx); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh baseFeeTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() == 0 {
+		return txh.next.DeliverTx(ctx, req)
+	}
+
+	gasLimit, err := txh.checkBaseFee(ctx, req.Tx)
+	if err != nil {
+		return tx.Response{}, err
+	}
+
+	res, err := txh.next.DeliverTx(ctx, req)
+	if err != nil {
+		// The fee of a failed tx is not refunded, so its base fee is still collected.
+		txh.collectBaseFee(ctx, gasLimit, res)
+		return res, err
+	}
+
+	return txh.collectBaseFee(ctx, gasLimit, res), nil
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh baseFeeTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() == 0 {
+		return txh.next.SimulateTx(ctx, req)
+	}
+
+	gasLimit, checkErr := txh.checkBaseFee(ctx, req.Tx)
+
+	res, err := txh.next.SimulateTx(ctx, req)
+	if err != nil || checkErr != nil {
+		return res, err
+	}
+
+	return txh.collectBaseFee(ctx, gasLimit, res), nil
+}
@@ -0,0 +1,106 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *MWTestSuite) TestBaseFeeMiddleware() {
+	ctx := s.SetupTest(false) // setup
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr1, coins))
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(feeAmount)
+	txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	params := s.app.FeeMarketKeeper.GetParams(ctx)
+	params.Denom = "atom"
+	s.app.FeeMarketKeeper.SetParams(ctx, params)
+
+	gasUsed := uint64(50000)
+	consumeGasTxHandler := customTxHandler{func(ctx context.Context, _ tx.Request) (tx.Response, error) {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(gasUsed, "msgs")
+		return tx.Response{}, nil
+	}}
+	txHandler := middleware.ComposeMiddlewares(
+		consumeGasTxHandler,
+		middleware.GasTxMiddleware,
+		middleware.DeductFeeMiddleware(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper),
+		middleware.BaseFeeMiddleware(s.app.FeeMarketKeeper),
+	)
+
+	// the fee must cover the base fee of the gas limit
+	s.app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(1, 3))
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// the txs of the genesis block do not pay a base fee
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx.WithBlockHeight(0)), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+
+	// the base fee of the gas used is burnt from the fee collector
+	s.app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(5, 4))
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom")
+	supply := s.app.BankKeeper.GetSupply(ctx, "atom")
+
+	_, _, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+	s.Require().NoError(err)
+	res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+
+	baseFee := s.app.FeeMarketKeeper.RequiredFee(ctx, res.GasUsed)
+	s.Require().True(baseFee.Amount.GTE(sdk.NewInt(25)))
+	// CheckTx and DeliverTx both deducted the fee, and DeliverTx burnt the base fee
+	s.Require().Equal(feeCollectorBalance.Add(feeAmount[0]).Add(feeAmount[0]).Sub(baseFee), s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom"))
+	s.Require().Equal(supply.Sub(baseFee), s.app.BankKeeper.GetSupply(ctx, "atom"))
+
+	// a collection which fails is discarded without failing the tx, or replacing its error
+	s.app.GetSubspace(feemarkettypes.ModuleName).Set(ctx, feemarkettypes.KeyBaseFeeRecipient, "unknown")
+	feeCollectorBalance = s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom")
+	res, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Events)
+	s.Require().Equal(feeCollectorBalance.Add(feeAmount[0]), s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom"))
+
+	s.Require().NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr1, coins))
+	txErr := errors.New("tx failed")
+	failingTxHandler := middleware.ComposeMiddlewares(
+		customTxHandler{func(_ context.Context, _ tx.Request) (tx.Response, error) {
+			return tx.Response{}, txErr
+		}},
+		middleware.GasTxMiddleware,
+		middleware.DeductFeeMiddleware(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper),
+		middleware.BaseFeeMiddleware(s.app.FeeMarketKeeper),
+	)
+	_, err = failingTxHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().Equal(txErr, err)
+}
@@ -18,3 +18,17 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeMarketKeeper defines the expected fee market keeper, which sets the base
+// fee txs must pay for their gas.
+type FeeMarketKeeper interface {
+	RequiredFee(ctx sdk.Context, gasLimit uint64) sdk.Coin
+	CollectBaseFee(ctx sdk.Context, baseFee sdk.Coin) error
+}
+
+// UnorderedTxKeeper defines the expected keeper of the hashes of unordered txs,
+// which prevents their replay until their timeout height.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64)
+}
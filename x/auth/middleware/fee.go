@@ -88,6 +88,8 @@ type deductFeeTxHandler struct {
 }
 
 // DeductFeeMiddleware deducts fees from the first signer of the tx
+// If the tx has a fee granter, fees are deducted from the granter, provided the
+// granter has a fee grant or a sponsor pool covering the fee payer
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next middleware if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use deductFeeTxHandler
@@ -167,6 +167,71 @@ func (s *MWTestSuite) TestDeductFeesNoDelegation() {
 	}
 }
 
+func (s *MWTestSuite) TestDeductFeesSponsorPool() {
+	ctx := s.SetupTest(false) // setup
+	app := s.app
+
+	protoTxCfg := tx.NewTxConfig(codec.NewProtoCodec(app.InterfaceRegistry()), tx.DefaultSignModes)
+
+	// keys and addresses
+	_, _, sponsor := testdata.KeyTestPubAddr()
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+
+	err := testutil.FundAccount(s.app.BankKeeper, ctx, sponsor, []sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(99999))})
+	s.Require().NoError(err)
+
+	// the fee payers have accounts but no funds
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+
+	// sponsor test msgs of any account, at most one tx per account and hour
+	pool := feegrant.NewSponsorPool(sponsor, []string{sdk.MsgTypeURL(&testdata.TestMsg{})},
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), nil, time.Hour, nil, 1)
+	err = app.FeeGrantKeeper.CreateSponsorPool(ctx, pool)
+	s.Require().NoError(err)
+
+	cases := []struct {
+		name      string
+		signerKey cryptotypes.PrivKey
+		signer    sdk.AccAddress
+		msgs      []sdk.Msg
+		fee       int64
+		valid     bool
+	}{
+		{"account without funds is sponsored", priv1, addr1, []sdk.Msg{testdata.NewTestMsg(addr1)}, 50, true},
+		{"rate limited account", priv1, addr1, []sdk.Msg{testdata.NewTestMsg(addr1)}, 50, false},
+		{"msg not allowed", priv2, addr2, []sdk.Msg{&testdata.MsgCreateDog{Dog: &testdata.Dog{}}}, 50, false},
+		{"fee above pool spend limit", priv2, addr2, []sdk.Msg{testdata.NewTestMsg(addr2)}, 1000, false},
+		{"other account is sponsored", priv2, addr2, []sdk.Msg{testdata.NewTestMsg(addr2)}, 50, true},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			fee := sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee))
+
+			acc := app.AccountKeeper.GetAccount(ctx, tc.signer)
+			privs, accNums, seqs := []cryptotypes.PrivKey{tc.signerKey}, []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}
+
+			testTx, err := genTxWithFeeGranter(protoTxCfg, tc.msgs, fee, helpers.DefaultGenTxGas, ctx.ChainID(), accNums, seqs, sponsor, privs...)
+			s.Require().NoError(err)
+
+			_, err = s.txHandler.DeliverTx(sdk.WrapSDKContext(ctx), txtypes.Request{Tx: testTx})
+			if tc.valid {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+
+	pool2, err := app.FeeGrantKeeper.GetSponsorPool(ctx, sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 400)), pool2.SpendLimit)
+	s.Require().Equal(sdk.NewInt(99899), app.BankKeeper.GetBalance(ctx, sponsor, "atom").Amount)
+}
+
 // don't consume any gas
 func SigGasNoConsumer(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params authtypes.Params) error {
 	return nil
@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ tx.Handler = mempoolTxHandler{}
+
+type mempoolTxHandler struct {
+	mempool       mempool.Mempool
+	accountKeeper AccountKeeper
+	next          tx.Handler
+}
+
+// MempoolMiddleware keeps the app-side mempool in sync with the txs checked and
+// delivered by the app. New txs which pass CheckTx are inserted with the
+// priority returned by CheckTx, and the txs which fail a recheck or are
+// delivered in a block are removed. A new tx the mempool does not accept, e.g.
+// because it is full, fails CheckTx. As the mempool is local to the node, the
+// removal of txs never changes the result of CheckTx or DeliverTx. A nil
+// mempool disables the middleware.
+//
+// A new tx with the same sender and sequence as a pending tx of the mempool is
+// checked as a replacement of the pending tx: it is checked against the
+// sequence of the pending tx, and replaces it if the mempool accepts it, e.g.
+// if its fee is high enough. A replaced tx fails its next recheck, so it is
+// evicted from the Tendermint mempool. Only the sequence of the sender, the
+// first signer of the tx, is checked against the pending tx. The fees of both
+// txs are deducted in the check state until the next block resets it.
+//
+// Unordered txs, which have no sequence, are not kept in the mempool.
+//
+// It must be put after the TxDecoderMiddleware, and before the
+// TxPriorityMiddleware so the priority of txs is known.
+func MempoolMiddleware(mp mempool.Mempool, ak AccountKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		if mp == nil {
+			return txh
+		}
+
+		return mempoolTxHandler{
+			mempool:       mp,
+			accountKeeper: ak,
+			next:          txh,
+		}
+	}
+}
+
+// CheckTx implements tx.Handler.CheckTx. The tx is checked on a branch of the
+// check state, which is only written if the tx is accepted by the mempool, so
+// the txs it rejects do not change the check state.
+func (txh mempoolTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if hasUnorderedFlag(req.Tx) {
+		return txh.next.CheckTx(ctx, req, checkReq)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	var restoreSequence func()
+	switch checkReq.Type {
+	case abci.CheckTxType_New:
+		var err error
+		restoreSequence, err = txh.rewindSequence(cacheCtx, req.Tx)
+		if err != nil {
+			return tx.Response{}, tx.ResponseCheckTx{}, err
+		}
+
+	case abci.CheckTxType_Recheck:
+		if err := txh.checkNotReplaced(sdkCtx, req.Tx); err != nil {
+			return tx.Response{}, tx.ResponseCheckTx{}, err
+		}
+	}
+
+	res, checkRes, err := txh.next.CheckTx(sdk.WrapSDKContext(cacheCtx), req, checkReq)
+	if err != nil {
+		if checkReq.Type == abci.CheckTxType_Recheck {
+			txh.removeTx(ctx, req.Tx)
+		}
+		return res, checkRes, err
+	}
+
+	if checkReq.Type == abci.CheckTxType_New {
+		if err := txh.mempool.Insert(sdkCtx.WithPriority(checkRes.Priority), req.Tx); err != nil {
+			return tx.Response{}, tx.ResponseCheckTx{}, err
+		}
+	}
+	if restoreSequence != nil {
+		restoreSequence()
+	}
+	writeCache()
+
+	return res, checkRes, nil
+}
+
+// rewindSequence sets the sequence of the sender of a tx back to the sequence
+// of the tx, if it replaces a pending tx of the mempool. It returns a function
+// which sets the sequence back to its value before the tx, or nil if the tx
+// replaces no pending tx.
+func (txh mempoolTxHandler) rewindSequence(ctx sdk.Context, sdkTx sdk.Tx) (func(), error) {
+	sender, nonce, err := mempool.SenderNonce(sdkTx)
+	if err != nil {
+		// The tx is left to fail the checks of the next middlewares.
+		return nil, nil
+	}
+
+	if _, ok := txh.mempool.Lookup(sender, nonce); !ok {
+		return nil, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := txh.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil || acc.GetSequence() <= nonce {
+		return nil, nil
+	}
+
+	sequence := acc.GetSequence()
+	if err := acc.SetSequence(nonce); err != nil {
+		return nil, err
+	}
+	txh.accountKeeper.SetAccount(ctx, acc)
+
+	return func() {
+		acc := txh.accountKeeper.GetAccount(ctx, addr)
+		if err := acc.SetSequence(sequence); err != nil {
+			panic(err)
+		}
+		txh.accountKeeper.SetAccount(ctx, acc)
+	}, nil
+}
+
+// checkNotReplaced returns an error if a pending tx was replaced by another tx
+// of the mempool, with the same sender and sequence.
+func (txh mempoolTxHandler) checkNotReplaced(ctx sdk.Context, sdkTx sdk.Tx) error {
+	sender, nonce, err := mempool.SenderNonce(sdkTx)
+	if err != nil {
+		return nil
+	}
+
+	txBytes, ok := txh.mempool.Lookup(sender, nonce)
+	if ok && !bytes.Equal(txBytes, ctx.TxBytes()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "tx with sequence %d of %s was replaced", nonce, sender)
+	}
+
+	return nil
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh mempoolTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if hasUnorderedFlag(req.Tx) {
+		return txh.next.DeliverTx(ctx, req)
+	}
+
+	res, err := txh.next.DeliverTx(ctx, req)
+
+	// The tx is in a block, so it leaves the mempool whether it succeeded or not.
+	txh.removeTx(ctx, req.Tx)
+
+	return res, err
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh mempoolTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return txh.next.SimulateTx(ctx, req)
+}
+
+// removeTx removes a tx from the mempool, if it is in it. Txs which are not in
+// the mempool, e.g. the txs of blocks proposed by other nodes, are ignored.
+func (txh mempoolTxHandler) removeTx(ctx context.Context, sdkTx sdk.Tx) {
+	err := txh.mempool.Remove(sdkTx)
+	if err != nil && !errors.Is(err, sdkerrors.ErrNotFound) {
+		sdk.UnwrapSDKContext(ctx).Logger().Debug("failed to remove tx from mempool", "err", err)
+	}
+}
+
+// hasUnorderedFlag returns whether a tx is unordered, so it has no sequence.
+func hasUnorderedFlag(sdkTx sdk.Tx) bool {
+	unorderedTx, ok := sdkTx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
@@ -0,0 +1,175 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *MWTestSuite) TestMempoolMiddleware() {
+	ctx := s.SetupTest(true) // setup
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+
+	newTestTx := func(seq uint64) sdk.Tx {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(feeAmount)
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{seq}
+		testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+		s.Require().NoError(err)
+		return testTx
+	}
+	tx0, tx1 := newTestTx(0), newTestTx(1)
+
+	cfg := mempool.DefaultPriorityNonceConfig()
+	cfg.MaxTx = 1
+	mp := mempool.NewPriorityNonceMempool(cfg)
+
+	// the tx handler writes the sequence of the checked tx, and fails if told to
+	key := []byte("checked")
+	var fail bool
+	writeTxHandler := customTxHandler{func(ctx context.Context, req tx.Request) (tx.Response, error) {
+		_, nonce, err := mempool.SenderNonce(req.Tx)
+		s.Require().NoError(err)
+		sdk.UnwrapSDKContext(ctx).KVStore(s.app.GetKey(authtypes.StoreKey)).Set(key, sdk.Uint64ToBigEndian(nonce))
+		if fail {
+			return tx.Response{}, errors.New("tx failed")
+		}
+		return tx.Response{}, nil
+	}}
+	txHandler := middleware.ComposeMiddlewares(
+		writeTxHandler,
+		middleware.MempoolMiddleware(mp, s.app.AccountKeeper),
+		middleware.TxPriorityMiddleware,
+	)
+	checkTx := func(sdkTx sdk.Tx, typ abci.CheckTxType) error {
+		_, _, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: sdkTx}, tx.RequestCheckTx{Type: typ})
+		return err
+	}
+	store := ctx.KVStore(s.app.GetKey(authtypes.StoreKey))
+
+	// a new tx which passes CheckTx is inserted
+	s.Require().NoError(checkTx(tx0, abci.CheckTxType_New))
+	s.Require().Equal(1, mp.CountTx())
+	s.Require().Equal(sdk.Uint64ToBigEndian(0), store.Get(key))
+
+	// a tx which the mempool does not accept fails CheckTx, and its writes are discarded
+	err := checkTx(tx1, abci.CheckTxType_New)
+	s.Require().ErrorIs(err, sdkerrors.ErrMempoolIsFull)
+	s.Require().Equal(sdk.Uint64ToBigEndian(0), store.Get(key))
+
+	// a tx which passes a recheck stays in the mempool, and one which fails it is removed
+	s.Require().NoError(checkTx(tx0, abci.CheckTxType_Recheck))
+	s.Require().Equal(1, mp.CountTx())
+	fail = true
+	s.Require().Error(checkTx(tx0, abci.CheckTxType_Recheck))
+	s.Require().Equal(0, mp.CountTx())
+
+	// a delivered tx is removed, whether it succeeded or not
+	fail = false
+	s.Require().NoError(checkTx(tx1, abci.CheckTxType_New))
+	s.Require().Equal(1, mp.CountTx())
+	fail = true
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: tx1})
+	s.Require().Error(err)
+	s.Require().Equal(0, mp.CountTx())
+
+	// delivering a tx which is not in the mempool succeeds
+	fail = false
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: tx0})
+	s.Require().NoError(err)
+}
+
+func (s *MWTestSuite) TestMempoolMiddlewareReplacement() {
+	ctx := s.SetupTest(true) // setup
+	accounts := s.createTestAccounts(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	acc := accounts[0]
+	msg := testdata.NewTestMsg(acc.acc.GetAddress())
+
+	newTestTx := func(seq uint64, fee int64) (sdk.Tx, []byte) {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msg))
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", fee)))
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{acc.priv}, []uint64{acc.accNum}, []uint64{seq}
+		testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+		s.Require().NoError(err)
+		txBytes, err := s.clientCtx.TxConfig.TxEncoder()(testTx)
+		s.Require().NoError(err)
+		return testTx, txBytes
+	}
+	pending, pendingBytes := newTestTx(0, 150)
+	underpriced, underpricedBytes := newTestTx(0, 160)
+	replacement, replacementBytes := newTestTx(0, 200)
+	next, nextBytes := newTestTx(1, 150)
+
+	mp := mempool.NewPriorityNonceMempool(mempool.DefaultPriorityNonceConfig())
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.MempoolMiddleware(mp, s.app.AccountKeeper),
+		middleware.TxPriorityMiddleware,
+		middleware.SetPubKeyMiddleware(s.app.AccountKeeper),
+		middleware.SigVerificationMiddleware(s.app.AccountKeeper, s.clientCtx.TxConfig.SignModeHandler()),
+		middleware.IncrementSequenceMiddleware(s.app.AccountKeeper),
+	)
+	checkTx := func(sdkTx sdk.Tx, txBytes []byte, typ abci.CheckTxType) error {
+		ctx := sdk.WrapSDKContext(ctx.WithTxBytes(txBytes))
+		_, _, err := txHandler.CheckTx(ctx, tx.Request{Tx: sdkTx, TxBytes: txBytes}, tx.RequestCheckTx{Type: typ})
+		return err
+	}
+	sequence := func() uint64 {
+		return s.app.AccountKeeper.GetAccount(ctx, acc.acc.GetAddress()).GetSequence()
+	}
+
+	s.Require().NoError(checkTx(pending, pendingBytes, abci.CheckTxType_New))
+	s.Require().Equal(uint64(1), sequence())
+
+	// a tx with the same sequence and a fee which is not high enough is rejected
+	err := checkTx(underpriced, underpricedBytes, abci.CheckTxType_New)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	s.Require().Equal(uint64(1), sequence())
+
+	// a tx with the same sequence and a high enough fee replaces the pending tx,
+	// and the sequence is left unchanged
+	s.Require().NoError(checkTx(replacement, replacementBytes, abci.CheckTxType_New))
+	s.Require().Equal(uint64(1), sequence())
+	s.Require().Equal(1, mp.CountTx())
+	txBytes, ok := mp.Lookup(acc.acc.GetAddress().String(), 0)
+	s.Require().True(ok)
+	s.Require().Equal(replacementBytes, txBytes)
+
+	// the next tx of the sender is checked against the sequence after the pending tx
+	s.Require().NoError(checkTx(next, nextBytes, abci.CheckTxType_New))
+	s.Require().Equal(uint64(2), sequence())
+	s.Require().Equal(2, mp.CountTx())
+
+	// after a new block, the replaced tx fails its recheck, and the replacement passes it
+	ctx = ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+	seqAcc := s.app.AccountKeeper.GetAccount(ctx, acc.acc.GetAddress())
+	s.Require().NoError(seqAcc.SetSequence(0))
+	s.app.AccountKeeper.SetAccount(ctx, seqAcc)
+
+	err = checkTx(pending, pendingBytes, abci.CheckTxType_Recheck)
+	s.Require().ErrorIs(err, sdkerrors.ErrWrongSequence)
+	s.Require().Equal(2, mp.CountTx())
+	s.Require().NoError(checkTx(replacement, replacementBytes, abci.CheckTxType_Recheck))
+	s.Require().NoError(checkTx(next, nextBytes, abci.CheckTxType_Recheck))
+	s.Require().Equal(uint64(2), sequence())
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -48,6 +49,28 @@ type TxHandlerOptions struct {
 	FeegrantKeeper  FeegrantKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+
+	// GasRefundRatio is the fraction of the fees paid for the gas a tx did not
+	// use which is refunded after its msgs run. Refunds are disabled if it is nil
+	// or zero.
+	GasRefundRatio sdk.Dec
+
+	// FeeMarketKeeper sets the base fee which the fees of txs must cover. The
+	// base fee is disabled if it is nil.
+	FeeMarketKeeper FeeMarketKeeper
+
+	// Mempool is the app-side mempool, which is kept in sync with the txs
+	// checked and delivered by the app. It is disabled if it is nil.
+	Mempool mempool.Mempool
+
+	// UnorderedTxKeeper keeps the hashes of unordered txs until their timeout
+	// height, to prevent their replay. Unordered txs are rejected if it is nil.
+	UnorderedTxKeeper UnorderedTxKeeper
+
+	// MaxUnorderedTxTimeoutHeightDelta is the maximum number of blocks after
+	// the current height at which unordered txs can time out. It bounds the
+	// number of unordered tx hashes kept in state.
+	MaxUnorderedTxTimeoutHeightDelta uint64
 }
 
 // NewDefaultTxHandler defines a TxHandler middleware stacks that should work
@@ -69,6 +92,14 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for middlewares")
 	}
 
+	if !options.GasRefundRatio.IsNil() && (options.GasRefundRatio.IsNegative() || options.GasRefundRatio.GT(sdk.OneDec())) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "gas refund ratio must be between 0 and 1: %s", options.GasRefundRatio)
+	}
+
+	if options.UnorderedTxKeeper != nil && options.MaxUnorderedTxTimeoutHeightDelta == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "max unordered tx timeout height delta is required for unordered txs")
+	}
+
 	var sigGasConsumer = options.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = DefaultSigVerificationGasConsumer
@@ -77,6 +108,8 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 	return ComposeMiddlewares(
 		NewRunMsgsTxHandler(options.MsgServiceRouter, options.LegacyRouter),
 		NewTxDecoderMiddleware(options.TxDecoder),
+		// Inserts checked txs into the app-side mempool, and removes delivered txs.
+		MempoolMiddleware(options.Mempool, options.AccountKeeper),
 		// Set a new GasMeter on sdk.Context.
 		//
 		// Make sure the Gas middleware is outside of all other middlewares
@@ -102,7 +135,14 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		// `DeductFeeMiddleware` and `IncrementSequenceMiddleware` should be put outside of `WithBranchedStore` middleware,
 		// so their storage writes are not discarded when tx fails.
 		DeductFeeMiddleware(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		// Enforces the base fee of the fee market, and collects it once the gas used by the tx is known.
+		BaseFeeMiddleware(options.FeeMarketKeeper),
+		// Refunds the fees paid for unused gas once the gas used by the tx is known.
+		GasRefundMiddleware(options.BankKeeper, options.GasRefundRatio),
 		TxPriorityMiddleware,
+		// Prevents the replay of unordered txs by their hash instead of the sequence
+		// of their signers, so it must be put before the sig verification middlewares.
+		UnorderedTxMiddleware(options.UnorderedTxKeeper, options.MaxUnorderedTxTimeoutHeightDelta),
 		SetPubKeyMiddleware(options.AccountKeeper),
 		ValidateSigCountMiddleware(options.AccountKeeper),
 		SigGasConsumeMiddleware(options.AccountKeeper, sigGasConsumer),
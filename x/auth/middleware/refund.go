@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ tx.Handler = gasRefundTxHandler{}
+
+type gasRefundTxHandler struct {
+	bankKeeper  types.BankKeeper
+	refundRatio sdk.Dec
+	next        tx.Handler
+}
+
+// GasRefundMiddleware refunds a fraction of the fees of a tx for the gas it did
+// not use, after its msgs run successfully in DeliverTx or SimulateTx. The refund
+// of each fee coin is refundRatio * fee * (gasLimit - gasUsed) / gasLimit, rounded
+// down, and is sent from the fee collector to the fee granter of the tx, or to its
+// fee payer if it has none. A nil or zero refundRatio disables refunds.
+// It must be put after DeductFeeMiddleware, and outside of WithBranchedStore so the
+// gas used by the tx is known. As the state changes of the tx are already written
+// then, refunds are best-effort: a refund which fails is logged and discarded, and
+// the tx succeeds without it.
+// CONTRACT: Tx must implement FeeTx interface to use gasRefundTxHandler
+func GasRefundMiddleware(bk types.BankKeeper, refundRatio sdk.Dec) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return gasRefundTxHandler{
+			bankKeeper:  bk,
+			refundRatio: refundRatio,
+			next:        txh,
+		}
+	}
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh gasRefundTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh gasRefundTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	res, err := txh.next.DeliverTx(ctx, req)
+
+	return txh.refundUnusedGas(ctx, req, res, err)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh gasRefundTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	res, err := txh.next.SimulateTx(ctx, req)
+
+	return txh.refundUnusedGas(ctx, req, res, err)
+}
+
+// refundUnusedGas refunds the fees paid for the unused gas of a tx which ran
+// successfully, and appends the refund's events to its response. The refund is
+// written only if it succeeds, and a failed refund is logged without failing the
+// tx, whose state changes are already written.
+func (txh gasRefundTxHandler) refundUnusedGas(ctx context.Context, req tx.Request, res tx.Response, err error) (tx.Response, error) {
+	if err != nil || txh.refundRatio.IsNil() || !txh.refundRatio.IsPositive() {
+		return res, err
+	}
+
+	feeTx, ok := req.Tx.(sdk.FeeTx)
+	if !ok {
+		return res, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	refund := GasRefund(feeTx.GetFee(), feeTx.GetGas(), sdkCtx.GasMeter().GasConsumed(), txh.refundRatio)
+	if refund.IsZero() {
+		return res, nil
+	}
+
+	refundTo := feeTx.FeePayer()
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		refundTo = feeGranter
+	}
+
+	// The gas used by the tx is already measured, so the refund is not metered.
+	refundCtx, writeRefund := sdkCtx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+	err = txh.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.FeeCollectorName, refundTo, refund)
+	if err != nil {
+		sdkCtx.Logger().Error("failed to refund unused gas", "refund", refund, "to", refundTo, "err", err)
+		return res, nil
+	}
+	writeRefund()
+
+	refundCtx.EventManager().EmitEvent(sdk.NewEvent(sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+	))
+	res.Events = append(res.Events, refundCtx.EventManager().ABCIEvents()...)
+
+	return res, nil
+}
+
+// GasRefund returns the refund of a fee for the gas a tx did not use, which is
+// refundRatio * fee * (gasLimit - gasUsed) / gasLimit for each fee coin, rounded
+// down.
+func GasRefund(fee sdk.Coins, gasLimit, gasUsed uint64, refundRatio sdk.Dec) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return sdk.NewCoins()
+	}
+
+	// The refund is computed with integers to be rounded down exactly, as the
+	// refund ratio's integer representation is scaled by the precision of sdk.Dec.
+	unused := new(big.Int).SetUint64(gasLimit - gasUsed)
+	denominator := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), sdk.OneDec().BigInt())
+
+	refund := make(sdk.Coins, 0, len(fee))
+	for _, coin := range fee {
+		amount := new(big.Int).Mul(coin.Amount.BigInt(), unused)
+		amount.Mul(amount, refundRatio.BigInt())
+		amount.Quo(amount, denominator)
+		refund = append(refund, sdk.NewCoin(coin.Denom, sdk.NewIntFromBigInt(amount)))
+	}
+
+	return sdk.NewCoins(refund...)
+}
@@ -0,0 +1,135 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestGasRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 7))
+
+	testCases := []struct {
+		name      string
+		gasLimit  uint64
+		gasUsed   uint64
+		ratio     sdk.Dec
+		expRefund sdk.Coins
+	}{
+		{"all gas unused", 1000, 0, sdk.OneDec(), fee},
+		{"half of the gas unused", 1000, 500, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 75), sdk.NewInt64Coin("stake", 3))},
+		{"half of the gas unused, half refunded", 1000, 500, sdk.NewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 37), sdk.NewInt64Coin("stake", 1))},
+		{"all gas used", 1000, 1000, sdk.OneDec(), sdk.NewCoins()},
+		{"more gas used than the limit", 1000, 2000, sdk.OneDec(), sdk.NewCoins()},
+		{"no gas limit", 0, 0, sdk.OneDec(), sdk.NewCoins()},
+		{"zero ratio", 1000, 0, sdk.ZeroDec(), sdk.NewCoins()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRefund, middleware.GasRefund(fee, tc.gasLimit, tc.gasUsed, tc.ratio))
+		})
+	}
+}
+
+func (s *MWTestSuite) TestGasRefundMiddleware() {
+	ctx := s.SetupTest(false) // setup
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(ctx, "mint", coins))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", addr1, coins))
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(feeAmount)
+	txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	ratio := sdk.NewDecWithPrec(5, 1)
+	newTxHandler := func(next tx.Handler) tx.Handler {
+		return middleware.ComposeMiddlewares(
+			next,
+			middleware.GasTxMiddleware,
+			middleware.DeductFeeMiddleware(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper),
+			middleware.GasRefundMiddleware(s.app.BankKeeper, ratio),
+		)
+	}
+
+	// the fee is not refunded if the tx fails
+	failingTxHandler := customTxHandler{func(_ context.Context, _ tx.Request) (tx.Response, error) {
+		return tx.Response{}, errors.New("tx failed")
+	}}
+	_, err = newTxHandler(failingTxHandler).DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().Error(err)
+	balance := coins.Sub(feeAmount)
+	s.Require().Equal(balance, s.app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// the fee for the unused gas is refunded, and the refund is in the tx events
+	res, err := newTxHandler(noopTxHandler).DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+	refund := middleware.GasRefund(feeAmount, gasLimit, res.GasUsed, ratio)
+	s.Require().False(refund.IsZero())
+	s.Require().Equal(balance.Sub(feeAmount).Add(refund...), s.app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	var refundAttrs []string
+	for _, event := range res.Events {
+		for _, attr := range event.Attributes {
+			if event.Type == sdk.EventTypeTx && string(attr.Key) == sdk.AttributeKeyFeeRefund {
+				refundAttrs = append(refundAttrs, string(attr.Value))
+			}
+		}
+	}
+	s.Require().Equal([]string{refund.String()}, refundAttrs)
+
+	// the refund is also in the simulation output
+	res, err = newTxHandler(noopTxHandler).SimulateTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+	s.Require().Contains(sdk.StringifyEvents(res.Events).String(), sdk.AttributeKeyFeeRefund)
+
+	// a refund which fails is discarded without failing the tx
+	drainFeesTxHandler := customTxHandler{func(ctx context.Context, _ tx.Request) (tx.Response, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		fees := s.app.BankKeeper.GetAllBalances(sdkCtx, feeCollector)
+		return tx.Response{}, s.app.BankKeeper.SendCoinsFromModuleToModule(sdkCtx, authtypes.FeeCollectorName, "mint", fees)
+	}}
+	balance = s.app.BankKeeper.GetAllBalances(ctx, addr1)
+	res, err = newTxHandler(drainFeesTxHandler).DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+	s.Require().NotContains(sdk.StringifyEvents(res.Events).String(), sdk.AttributeKeyFeeRefund)
+	s.Require().Equal(balance.Sub(feeAmount), s.app.BankKeeper.GetAllBalances(ctx, addr1))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)).IsZero())
+}
+
+func (s *MWTestSuite) TestInvalidGasRefundRatio() {
+	s.SetupTest(false) // setup
+
+	_, err := middleware.NewDefaultTxHandler(middleware.TxHandlerOptions{
+		AccountKeeper:   s.app.AccountKeeper,
+		BankKeeper:      s.app.BankKeeper,
+		SignModeHandler: s.clientCtx.TxConfig.SignModeHandler(),
+		TxDecoder:       s.clientCtx.TxConfig.TxDecoder(),
+		GasRefundRatio:  sdk.NewDecWithPrec(15, 1),
+	})
+	s.Require().Error(err)
+}
@@ -19,6 +19,7 @@ import (
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 var (
@@ -454,6 +455,10 @@ func (svd sigVerificationTxHandler) sigVerify(ctx context.Context, req tx.Reques
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// unordered txs are signed with the sequence of their signatures, which the
+	// unorderedTxHandler checked, instead of the account sequence
+	unordered := isUnorderedTx(sdkCtx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(sdkCtx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -467,10 +472,13 @@ func (svd sigVerificationTxHandler) sigVerify(ctx context.Context, req tx.Reques
 		}
 
 		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		sequence := acc.GetSequence()
+		if unordered {
+			sequence = sig.Sequence
+		} else if sig.Sequence != sequence {
 			return sdkerrors.Wrapf(
 				sdkerrors.ErrWrongSequence,
-				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+				"account sequence mismatch, expected %d, got %d", sequence, sig.Sequence,
 			)
 		}
 
@@ -486,7 +494,7 @@ func (svd sigVerificationTxHandler) sigVerify(ctx context.Context, req tx.Reques
 			Address:       signerAddrs[i].String(),
 			ChainID:       chainID,
 			AccountNumber: accNum,
-			Sequence:      acc.GetSequence(),
+			Sequence:      sequence,
 			PubKey:        pubKey,
 		}
 
@@ -547,7 +555,8 @@ type incrementSequenceTxHandler struct {
 // IncrementSequenceMiddleware handles incrementing sequences of all signers.
 // Use the incrementSequenceTxHandler middleware to prevent replay attacks. Note,
 // there is no need to execute incrementSequenceTxHandler on RecheckTX since
-// CheckTx would already bump the sequence number.
+// CheckTx would already bump the sequence number. The sequences of the signers
+// of unordered txs are not incremented, see UnorderedTxMiddleware.
 //
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
@@ -569,6 +578,11 @@ func (isd incrementSequenceTxHandler) incrementSeq(ctx context.Context, req tx.R
 		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// the replay of unordered txs is prevented by their hash instead
+	if isUnorderedTx(sdkCtx) {
+		return nil
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(sdkCtx, addr)
@@ -609,6 +623,154 @@ func (isd incrementSequenceTxHandler) SimulateTx(ctx context.Context, req tx.Req
 	return isd.next.SimulateTx(ctx, req)
 }
 
+// DefaultMaxUnorderedTxTimeoutHeightDelta is the default maximum number of
+// blocks after the current height at which unordered txs can time out.
+const DefaultMaxUnorderedTxTimeoutHeightDelta = 1000
+
+var _ tx.Handler = unorderedTxHandler{}
+
+type unorderedTxHandler struct {
+	utk                   UnorderedTxKeeper
+	maxTimeoutHeightDelta uint64
+	next                  tx.Handler
+}
+
+// unorderedTxKey is the key of the sdk.Context value which marks the txs whose
+// replay is prevented by the unorderedTxHandler.
+type unorderedTxKey struct{}
+
+// UnorderedTxMiddleware prevents the replay of unordered txs by their hash
+// instead of by the sequence of their signers. The hash of an unordered tx is
+// kept until its timeout height, which must be set, and be at most
+// maxTimeoutHeightDelta blocks after the current height. The signatures of
+// an unordered tx must be made with a sequence of 0, and not in
+// SIGN_MODE_LEGACY_AMINO_JSON, which does not sign the unordered flag. A nil
+// keeper rejects all unordered txs.
+//
+// The SigVerificationMiddleware and IncrementSequenceMiddleware only skip the
+// account sequences of the unordered txs this middleware accepted, so it must
+// be put before them.
+func UnorderedTxMiddleware(utk UnorderedTxKeeper, maxTimeoutHeightDelta uint64) tx.Middleware {
+	return func(h tx.Handler) tx.Handler {
+		return unorderedTxHandler{
+			utk:                   utk,
+			maxTimeoutHeightDelta: maxTimeoutHeightDelta,
+			next:                  h,
+		}
+	}
+}
+
+// checkUnorderedTx checks an unordered tx and records its hash, and returns the
+// context of the next middlewares. Other txs are left unchanged.
+func (utd unorderedTxHandler) checkUnorderedTx(ctx context.Context, req tx.Request) (context.Context, error) {
+	unorderedTx, ok := req.Tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		return ctx, nil
+	}
+
+	if utd.utk == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "unordered txs are not enabled")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeoutHeight := unorderedTx.GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+	if maxTimeoutHeight := uint64(sdkCtx.BlockHeight()) + utd.maxTimeoutHeightDelta; timeoutHeight > maxTimeoutHeight {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered tx timeout height %d is more than %d blocks after the current height", timeoutHeight, utd.maxTimeoutHeightDelta,
+		)
+	}
+
+	sigTx, ok := req.Tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sig := range sigs {
+		if sig.Sequence != 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "unordered tx must be signed with a sequence of 0, got %d", sig.Sequence)
+		}
+		if hasLegacyAminoSigner(sig.Data) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "unordered tx cannot be signed with %s", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		}
+	}
+
+	if len(req.TxBytes) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx bytes are required")
+	}
+
+	txHash := tmhash.Sum(req.TxBytes)
+	if utd.utk.ContainsUnorderedTx(sdkCtx, txHash, timeoutHeight) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx %X was already executed", txHash)
+	}
+	utd.utk.AddUnorderedTx(sdkCtx, txHash, timeoutHeight)
+
+	return sdk.WrapSDKContext(sdkCtx.WithValue(unorderedTxKey{}, true)), nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (utd unorderedTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	ctx, err := utd.checkUnorderedTx(ctx, req)
+	if err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return utd.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (utd unorderedTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	ctx, err := utd.checkUnorderedTx(ctx, req)
+	if err != nil {
+		return tx.Response{}, err
+	}
+
+	return utd.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (utd unorderedTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	ctx, err := utd.checkUnorderedTx(ctx, req)
+	if err != nil {
+		return tx.Response{}, err
+	}
+
+	return utd.next.SimulateTx(ctx, req)
+}
+
+// isUnorderedTx returns whether the tx of a context is an unordered tx accepted
+// by the unorderedTxHandler.
+func isUnorderedTx(ctx sdk.Context) bool {
+	unordered, _ := ctx.Value(unorderedTxKey{}).(bool)
+	return unordered
+}
+
+// hasLegacyAminoSigner returns whether any signer of a signature uses
+// SIGN_MODE_LEGACY_AMINO_JSON.
+func hasLegacyAminoSigner(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if hasLegacyAminoSigner(s) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign
 // a transaction.
 func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types.AccountI, error) {
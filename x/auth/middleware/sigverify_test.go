@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
@@ -410,3 +411,94 @@ func (s *MWTestSuite) TestIncrementSequenceMiddleware() {
 		s.Require().Equal(tc.expectedSeq, s.app.AccountKeeper.GetAccount(ctx, addr).GetSequence())
 	}
 }
+
+func (s *MWTestSuite) TestUnorderedTxMiddleware() {
+	ctx := s.SetupTest(true) // setup
+	ctx = ctx.WithBlockHeight(10)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	s.Require().NoError(acc.SetAccountNumber(uint64(50)))
+	s.Require().NoError(acc.SetSequence(uint64(5)))
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+
+	newUnorderedTx := func(timeoutHeight, seq uint64) (sdk.Tx, []byte) {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		txBuilder.SetTimeoutHeight(timeoutHeight)
+		s.Require().NoError(txBuilder.SetUnordered(true))
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{50}, []uint64{seq}
+		testTx, txBytes, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+		s.Require().NoError(err)
+		return testTx, txBytes
+	}
+
+	newTxHandler := func(utk middleware.UnorderedTxKeeper) tx.Handler {
+		return middleware.ComposeMiddlewares(
+			noopTxHandler,
+			middleware.UnorderedTxMiddleware(utk, 10),
+			middleware.SetPubKeyMiddleware(s.app.AccountKeeper),
+			middleware.SigVerificationMiddleware(s.app.AccountKeeper, s.clientCtx.TxConfig.SignModeHandler()),
+			middleware.IncrementSequenceMiddleware(s.app.AccountKeeper),
+		)
+	}
+	txHandler := newTxHandler(s.app.AccountKeeper)
+	deliverTx := func(txh tx.Handler, sdkTx sdk.Tx, txBytes []byte) error {
+		_, err := txh.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: sdkTx, TxBytes: txBytes})
+		return err
+	}
+
+	// an unordered tx is signed with a sequence of 0, and does not increment the sequence
+	testTx, txBytes := newUnorderedTx(20, 0)
+	s.Require().NoError(deliverTx(txHandler, testTx, txBytes))
+	s.Require().Equal(uint64(5), s.app.AccountKeeper.GetAccount(ctx, addr).GetSequence())
+
+	// it cannot be replayed until it times out
+	err := deliverTx(txHandler, testTx, txBytes)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(19))
+	err = deliverTx(txHandler, testTx, txBytes)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// another unordered tx of the same signer is accepted
+	testTx2, txBytes2 := newUnorderedTx(19, 0)
+	s.Require().NoError(deliverTx(txHandler, testTx2, txBytes2))
+
+	// unordered txs are rejected if they are disabled
+	testTx3, txBytes3 := newUnorderedTx(18, 0)
+	err = deliverTx(newTxHandler(nil), testTx3, txBytes3)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+
+	// unordered txs without the middleware are checked against the account sequence
+	err = deliverTx(middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.SetPubKeyMiddleware(s.app.AccountKeeper),
+		middleware.SigVerificationMiddleware(s.app.AccountKeeper, s.clientCtx.TxConfig.SignModeHandler()),
+	), testTx3, txBytes3)
+	s.Require().ErrorIs(err, sdkerrors.ErrWrongSequence)
+
+	// the timeout height must be set, and not too far
+	testTx, txBytes = newUnorderedTx(0, 0)
+	s.Require().ErrorIs(deliverTx(txHandler, testTx, txBytes), sdkerrors.ErrInvalidRequest)
+	testTx, txBytes = newUnorderedTx(21, 0)
+	s.Require().ErrorIs(deliverTx(txHandler, testTx, txBytes), sdkerrors.ErrInvalidRequest)
+
+	// the signatures must be made with a sequence of 0
+	testTx, txBytes = newUnorderedTx(20, 5)
+	s.Require().ErrorIs(deliverTx(txHandler, testTx, txBytes), sdkerrors.ErrWrongSequence)
+
+	// the signatures must not be made in SIGN_MODE_LEGACY_AMINO_JSON
+	txBuilder, err := s.clientCtx.TxConfig.WrapTxBuilder(testTx3)
+	s.Require().NoError(err)
+	s.Require().NoError(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: priv.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+	}))
+	txBytes, err = s.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	s.Require().ErrorIs(deliverTx(txHandler, txBuilder.GetTx(), txBytes), sdkerrors.ErrNotSupported)
+	s.Require().Equal(uint64(5), s.app.AccountKeeper.GetAccount(ctx, addr).GetSequence())
+}
@@ -71,6 +71,15 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetUnordered implements TxBuilder.SetUnordered. StdTx does not support
+// unordered txs, so only false is accepted, which is a no-op.
+func (s *StdTxBuilder) SetUnordered(unordered bool) error {
+	if unordered {
+		return sdkerrors.ErrLogic.Wrap("cannot use unordered txs with StdTxBuilder")
+	}
+	return nil
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
@@ -35,6 +35,7 @@ type wrapper struct {
 
 var (
 	_ authsigning.Tx                   = &wrapper{}
+	_ sdk.TxWithUnordered              = &wrapper{}
 	_ client.TxBuilder                 = &wrapper{}
 	_ tx.TipTx                         = &wrapper{}
 	_ middleware.HasExtensionOptionsTx = &wrapper{}
@@ -175,6 +176,10 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -231,6 +236,16 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetUnordered sets whether the transaction is unordered.
+func (w *wrapper) SetUnordered(unordered bool) error {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+
+	return nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
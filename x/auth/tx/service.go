@@ -13,13 +13,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	pagination "github.com/cosmos/cosmos-sdk/types/query"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
-// baseAppSimulateFn is the signature of the Baseapp#Simulate function.
-type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+// baseAppSimulateFn is the signature of the Baseapp#SimulateWithAccesses function.
+type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, []*storetypes.StoreAccesses, error)
 
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
@@ -114,14 +115,15 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
-	gasInfo, result, err := s.simulate(txBytes)
+	gasInfo, result, accesses, err := s.simulate(txBytes)
 	if err != nil {
 		return nil, err
 	}
 
 	return &txtypes.SimulateResponse{
-		GasInfo: &gasInfo,
-		Result:  result,
+		GasInfo:  &gasInfo,
+		Result:   result,
+		Accesses: accesses,
 	}, nil
 }
 
@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authtest "github.com/cosmos/cosmos-sdk/x/auth/client/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -132,6 +133,14 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 				s.Require().Len(res.GetResult().MsgResponses, 1)
 				// Check the result and gas used are correct.
 				s.Require().True(res.GetGasInfo().GetGasUsed() > 0) // Gas used sometimes change, just check it's not empty.
+				// Check the accounts and balances written by the fee payment and send.
+				var storeKeys []string
+				for _, accesses := range res.GetAccesses() {
+					if len(accesses.Writes) > 0 {
+						storeKeys = append(storeKeys, accesses.StoreKey)
+					}
+				}
+				s.Require().Equal([]string{authtypes.StoreKey, banktypes.StoreKey}, storeKeys)
 			}
 		})
 	}
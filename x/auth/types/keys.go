@@ -22,6 +22,9 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedTxKeyPrefix prefix for the hashes of unordered txs, by timeout height
+	UnorderedTxKeyPrefix = []byte{0x02}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +33,15 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// UnorderedTxTimeoutKey returns the prefix of the keys of the unordered txs
+// which time out at a height.
+func UnorderedTxTimeoutKey(timeoutHeight uint64) []byte {
+	return append(UnorderedTxKeyPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+}
+
+// UnorderedTxKey returns the key of an unordered tx, from its hash and the
+// height it times out at.
+func UnorderedTxKey(timeoutHeight uint64, txHash []byte) []byte {
+	return append(UnorderedTxTimeoutKey(timeoutHeight), txHash...)
+}
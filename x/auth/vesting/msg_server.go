@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -17,12 +18,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -180,19 +182,112 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	if acc := ak.GetAccount(ctx, to); acc != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
-	}
-
 	var totalCoins sdk.Coins
 
 	for _, period := range msg.VestingPeriods {
 		totalCoins = totalCoins.Add(period.Amount...)
 	}
 
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	madeNewAcc := false
+	switch acc := ak.GetAccount(ctx, to).(type) {
+	case nil:
+		baseAccount := ak.NewAccountWithAddress(ctx, to)
+		ak.SetAccount(ctx, types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins.Sort(), msg.StartTime, msg.VestingPeriods))
+		madeNewAcc = true
+
+	case *types.PeriodicVestingAccount:
+		if !msg.Merge {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists; consider using --merge", msg.ToAddress)
+		}
+		acc.AddGrant(msg.StartTime, msg.VestingPeriods)
+		ak.SetAccount(ctx, acc)
+
+	case *authtypes.BaseAccount:
+		if !msg.Merge {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists; consider using --merge", msg.ToAddress)
+		}
+		// the coins the account delegated so far are not vesting
+		pva := types.NewPeriodicVestingAccount(acc, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+		pva.DelegatedFree = s.delegatedCoins(ctx, to)
+		ak.SetAccount(ctx, pva)
+
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists and cannot receive a periodic vesting grant; got: %T", msg.ToAddress, acc)
+	}
+
+	defer func() {
+		if madeNewAcc {
+			telemetry.IncrCounter(1, "new", "account")
+		}
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	totalCoins := msg.GetTotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
 	baseAccount := ak.NewAccountWithAddress(ctx, to)
+	baseAcc, ok := baseAccount.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
 
-	acc := types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	acc := types.NewClawbackVestingAccount(baseAcc, from, totalCoins, msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
 
 	ak.SetAccount(ctx, acc)
 
@@ -202,7 +297,7 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
 					float32(a.Amount.Int64()),
 					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
 				)
@@ -221,6 +316,173 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	)
-	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", va.FunderAddress)
+	}
+
+	// delegations are assumed to hold the unvested coins first, the rest of
+	// the unvested coins must be in the account balance
+	delegatedVesting := va.DelegatedVesting
+	unvested := va.ComputeClawback(ctx.BlockTime().Unix())
+	if unvested.IsZero() {
+		return &types.MsgClawbackResponse{}, nil
+	}
+
+	// the new schedule unlocks the clawed back coins held in the balance
+	spendable, hasNeg := bk.GetAllBalances(ctx, addr).SafeSub(va.LockedCoins(ctx.BlockTime()))
+	if hasNeg {
+		spendable = sdk.NewCoins()
+	}
+	toXfer := unvested.Sub(types.CoinsMin(unvested, delegatedVesting))
+	toXfer = types.CoinsMin(toXfer, spendable)
+
+	undelegated, err := s.clawbackStaked(ctx, addr, dest, unvested.Sub(toXfer))
+	if err != nil {
+		return nil, err
+	}
+
+	va.TrackClawbackUndelegation(ctx.BlockTime(), undelegated)
+	ak.SetAccount(ctx, va)
+
+	if err := bk.SendCoins(ctx, addr, dest, toXfer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// delegatedCoins returns the bond denom tokens the account has bonded or
+// unbonding in the staking module.
+func (s msgServer) delegatedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	sk := s.StakingKeeper
+	delegated := sdk.ZeroInt()
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		delegated = delegated.Add(validator.TokensFromSharesTruncated(delegation.GetShares()).TruncateInt())
+	}
+
+	for _, unbonding := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		for _, entry := range unbonding.Entries {
+			delegated = delegated.Add(entry.Balance)
+		}
+	}
+
+	if !delegated.IsPositive() {
+		return nil
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(sk.BondDenom(ctx), delegated))
+}
+
+// clawbackStaked transfers up to the bond denom amount of want from the
+// unbonding delegations, then from the delegations of addr to dest. It returns
+// the coins transferred, which can be less than wanted if the delegations were
+// slashed.
+func (s msgServer) clawbackStaked(ctx sdk.Context, addr, dest sdk.AccAddress, want sdk.Coins) (sdk.Coins, error) {
+	sk := s.StakingKeeper
+	bondDenom := sk.BondDenom(ctx)
+	wantAmt := want.AmountOf(bondDenom)
+	transferred := sdk.ZeroInt()
+
+	for _, unbonding := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !wantAmt.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(unbonding.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		amt, err := sk.TransferUnbonding(ctx, addr, dest, valAddr, wantAmt)
+		if err != nil {
+			return nil, err
+		}
+		wantAmt = wantAmt.Sub(amt)
+		transferred = transferred.Add(amt)
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !wantAmt.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			// should not happen, ignore the delegation
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(wantAmt)
+		if err != nil {
+			// the validator has no tokens left
+			continue
+		}
+
+		shares, err := sk.TransferDelegation(ctx, addr, dest, valAddr, wantShares)
+		if err != nil {
+			return nil, err
+		}
+
+		// round the transferred tokens down so that the account keeps
+		// tracking at least the tokens it still has delegated
+		amt := validator.TokensFromSharesTruncated(shares).TruncateInt()
+		wantAmt = wantAmt.Sub(sdk.MinInt(amt, wantAmt))
+		transferred = transferred.Add(amt)
+	}
+
+	if !transferred.IsPositive() {
+		return sdk.NewCoins(), nil
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, transferred)), nil
 }
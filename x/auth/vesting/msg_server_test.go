@@ -0,0 +1,218 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	addrs     []sdk.AccAddress
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	s.msgServer = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper)
+	s.addrs = simapp.AddTestAddrsIncremental(s.app, s.ctx, 3, sdk.NewInt(1000))
+}
+
+func (s *MsgServerTestSuite) createClawbackAccount(funder, addr sdk.AccAddress, lockupPeriods, vestingPeriods []types.Period) {
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, s.ctx.BlockTime().Unix(), lockupPeriods, vestingPeriods)
+	s.Require().NoError(msg.ValidateBasic())
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestCreateClawbackVestingAccount() {
+	funder, addr := s.addrs[0], sdk.AccAddress("clawback_account____")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	periods := []types.Period{{Length: 3600, Amount: coins}}
+
+	s.createClawbackAccount(funder, addr, nil, periods)
+
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(funder.String(), acc.FunderAddress)
+	s.Require().Equal(coins, acc.OriginalVesting)
+	s.Require().Equal(coins, s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, addr).IsZero())
+
+	// the account cannot be created twice
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, s.ctx.BlockTime().Unix(), nil, periods)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+}
+
+func (s *MsgServerTestSuite) TestClawback() {
+	funder, dest, addr := s.addrs[0], s.addrs[1], sdk.AccAddress("clawback_account____")
+	half := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	s.createClawbackAccount(funder, addr, nil, []types.Period{{Length: 3600, Amount: half}, {Length: 3600, Amount: half}})
+
+	// only the funder can claw back
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(dest, addr, nil))
+	s.Require().Error(err)
+
+	// only clawback vesting accounts can be clawed back
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(funder, dest, nil))
+	s.Require().Error(err)
+
+	// delegate 80 tokens while everything is vesting
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	_, err = s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(80), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+
+	// half of the tokens vest, then undelegate 10 tokens
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	shares, err := s.app.StakingKeeper.ValidateUnbondAmount(s.ctx, addr, validator.GetOperator(), sdk.NewInt(10))
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, addr, validator.GetOperator(), shares)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(funder, addr, dest))
+	s.Require().NoError(err)
+
+	// the unvested tokens are taken from the unbonding and bonded tokens
+	ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, dest, validator.GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(10), ubd.Entries[0].Balance)
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, addr, validator.GetOperator())
+	s.Require().False(found)
+
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, dest, validator.GetOperator())
+	s.Require().True(found)
+	validator, _ = s.app.StakingKeeper.GetValidator(s.ctx, validator.GetOperator())
+	s.Require().Equal(sdk.NewInt(40), validator.TokensFromShares(delegation.Shares).RoundInt())
+	delegation, found = s.app.StakingKeeper.GetDelegation(s.ctx, addr, validator.GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(30), validator.TokensFromShares(delegation.Shares).RoundInt())
+
+	// the remaining tokens are vested and unlocked
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(half, acc.OriginalVesting)
+	s.Require().True(acc.DelegatedVesting.IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)), acc.DelegatedFree)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+
+	// nothing is left to claw back
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(funder, addr, dest))
+	s.Require().NoError(err)
+	s.Require().Equal(half, s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount).OriginalVesting)
+}
+
+func (s *MsgServerTestSuite) TestClawbackLocked() {
+	funder, addr := s.addrs[0], sdk.AccAddress("clawback_account____")
+	half := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	total := half.Add(half...)
+	s.createClawbackAccount(funder, addr,
+		[]types.Period{{Length: 7200, Amount: total}},
+		[]types.Period{{Length: 3600, Amount: half}, {Length: 3600, Amount: half}},
+	)
+	funderBalance := s.app.BankKeeper.GetAllBalances(s.ctx, funder)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(funder, addr, nil))
+	s.Require().NoError(err)
+
+	// the unvested tokens are returned to the funder, the vested tokens stay locked
+	s.Require().Equal(funderBalance.Add(half...), s.app.BankKeeper.GetAllBalances(s.ctx, funder))
+	s.Require().Equal(half, s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, addr).IsZero())
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Require().Equal(half, s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+}
+
+func (s *MsgServerTestSuite) TestCreatePeriodicVestingAccountMerge() {
+	funder, addr := s.addrs[0], sdk.AccAddress("periodic_account____")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	start := s.ctx.BlockTime().Unix()
+	periods := []types.Period{{Length: 3600, Amount: coins}}
+
+	_, err := s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreatePeriodicVestingAccount(funder, addr, start, periods, false))
+	s.Require().NoError(err)
+
+	// a second grant needs the merge mode
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreatePeriodicVestingAccount(funder, addr, start+1800, periods, false))
+	s.Require().Error(err)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreatePeriodicVestingAccount(funder, addr, start+1800, periods, true))
+	s.Require().NoError(err)
+
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().Equal(coins.Add(coins...), acc.OriginalVesting)
+	s.Require().Equal(start+5400, acc.EndTime)
+	s.Require().Equal(coins.Add(coins...), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+
+	// the first grant vests first, the second one later
+	ctx := s.ctx.WithBlockTime(time.Unix(start+3600, 0))
+	s.Require().Equal(coins, s.app.BankKeeper.LockedCoins(ctx, addr))
+	ctx = s.ctx.WithBlockTime(time.Unix(start+5400, 0))
+	s.Require().True(s.app.BankKeeper.LockedCoins(ctx, addr).IsZero())
+}
+
+func (s *MsgServerTestSuite) TestCreatePeriodicVestingAccountMergeBaseAccount() {
+	funder, addr := s.addrs[0], s.addrs[1]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	start := s.ctx.BlockTime().Unix()
+	periods := []types.Period{{Length: 3600, Amount: coins}}
+	balance := s.app.BankKeeper.GetAllBalances(s.ctx, addr)
+
+	// the coins delegated before the conversion are free
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(100), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreatePeriodicVestingAccount(funder, addr, start, periods, true))
+	s.Require().NoError(err)
+
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(coins, acc.OriginalVesting)
+	s.Require().Equal(coins, acc.DelegatedFree)
+	s.Require().Equal(coins, s.app.BankKeeper.LockedCoins(s.ctx, addr))
+	s.Require().Equal(balance.Sub(coins), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+
+	// other account types cannot be merged into
+	clawbackAddr := sdk.AccAddress("clawback_account____")
+	s.createClawbackAccount(funder, clawbackAddr, nil, periods)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreatePeriodicVestingAccount(funder, clawbackAddr, start, periods, true))
+	s.Require().Error(err)
+}
+
+func (s *MsgServerTestSuite) TestCreatePeriodicVestingAccountChecks() {
+	funder := s.addrs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	start := s.ctx.BlockTime().Unix()
+	periods := []types.Period{{Length: 3600, Amount: coins}}
+
+	// blocked addresses cannot receive grants
+	blockedAddr := s.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	s.Require().True(s.app.BankKeeper.BlockedAddr(blockedAddr))
+	_, err := s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreatePeriodicVestingAccount(funder, blockedAddr, start, periods, true))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// nor can grants of coins which are not sendable
+	s.app.BankKeeper.SetParams(s.ctx, banktypes.DefaultParams().SetSendEnabledParam(sdk.DefaultBondDenom, false))
+	_, err = s.msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(s.ctx), types.NewMsgCreatePeriodicVestingAccount(funder, sdk.AccAddress("periodic_account____"), start, periods, false))
+	s.Require().ErrorIs(err, banktypes.ErrSendDisabled)
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
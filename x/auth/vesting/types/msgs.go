@@ -20,8 +20,18 @@ var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 var _ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 
+// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
 var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+var _ sdk.Msg = &MsgClawback{}
+
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //nolint:interfacer
 func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, endTime int64, delayed bool) *MsgCreateVestingAccount {
@@ -126,12 +136,13 @@ func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
 
 // NewMsgCreatePeriodicVestingAccount returns a reference to a new MsgCreatePeriodicVestingAccount.
 //nolint:interfacer
-func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreatePeriodicVestingAccount {
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period, merge bool) *MsgCreatePeriodicVestingAccount {
 	return &MsgCreatePeriodicVestingAccount{
 		FromAddress:    fromAddr.String(),
 		ToAddress:      toAddr.String(),
 		StartTime:      startTime,
 		VestingPeriods: periods,
+		Merge:          merge,
 	}
 }
 
@@ -142,12 +153,21 @@ func (msg MsgCreatePeriodicVestingAccount) Route() string { return RouterKey }
 func (msg MsgCreatePeriodicVestingAccount) Type() string { return TypeMsgCreatePeriodicVestingAccount }
 
 // GetSigners returns the expected signers for a MsgCreatePeriodicVestingAccount.
+// In merge mode, the recipient must sign as well, so that an existing account is
+// not turned into a vesting account without its consent.
 func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
 	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{from}
+	if !msg.Merge || msg.ToAddress == msg.FromAddress {
+		return []sdk.AccAddress{from}
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from, to}
 }
 
 // GetSignBytes returns the bytes all expected signers must sign over for a
@@ -186,3 +206,148 @@ func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	lockupCoins, err := validatePeriods(msg.LockupPeriods)
+	if err != nil {
+		return sdkerrors.Wrap(err, "lockup periods")
+	}
+	vestingCoins, err := validatePeriods(msg.VestingPeriods)
+	if err != nil {
+		return sdkerrors.Wrap(err, "vesting periods")
+	}
+
+	switch {
+	case len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "lockup and vesting periods cannot both be empty")
+	case len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 && !lockupCoins.IsEqual(vestingCoins):
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "lockup (%s) and vesting (%s) amounts must be equal", lockupCoins, vestingCoins)
+	}
+
+	return nil
+}
+
+// GetTotalAmount returns the coins transferred to the new account, which is
+// the total amount of the lockup or vesting periods.
+func (msg MsgCreateClawbackVestingAccount) GetTotalAmount() sdk.Coins {
+	if len(msg.LockupPeriods) > 0 {
+		return Periods(msg.LockupPeriods).TotalAmount()
+	}
+	return Periods(msg.VestingPeriods).TotalAmount()
+}
+
+// validatePeriods checks that all the periods have a positive length and a
+// valid positive amount, and returns the total amount of the periods.
+func validatePeriods(periods []Period) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	for i, period := range periods {
+		if period.Length < 1 {
+			return nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s in period %d", period.Amount, i)
+		}
+		total = total.Add(period.Amount...)
+	}
+
+	return total, nil
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. The destination
+// address may be nil, in which case the coins are returned to the funder.
+//nolint:interfacer
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	var destString string
+	if dest != nil {
+		destString = dest.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funder.String(),
+		Address:       addr.String(),
+		DestAddress:   destString,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{funder}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreatePeriodicVestingAccountGetSigners(t *testing.T) {
+	from, to := sdk.AccAddress("from________________"), sdk.AccAddress("to__________________")
+	periods := []types.Period{{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}}
+
+	msg := types.NewMsgCreatePeriodicVestingAccount(from, to, 1, periods, false)
+	require.Equal(t, []sdk.AccAddress{from}, msg.GetSigners())
+
+	// merging into an existing account needs its consent
+	msg = types.NewMsgCreatePeriodicVestingAccount(from, to, 1, periods, true)
+	require.Equal(t, []sdk.AccAddress{from, to}, msg.GetSigners())
+
+	msg = types.NewMsgCreatePeriodicVestingAccount(from, from, 1, periods, true)
+	require.Equal(t, []sdk.AccAddress{from}, msg.GetSigners())
+}
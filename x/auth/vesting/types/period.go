@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
@@ -26,3 +28,131 @@ func (vp Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// TotalLength returns the summed length of all the periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+
+	return total
+}
+
+// TotalAmount returns the sum of the coins of all the periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
+// readSchedule returns the coins released by the periods starting at
+// startTime at the given read time. All the coins are released once the read
+// time reaches the end of the schedule.
+func readSchedule(startTime int64, periods Periods, totalCoins sdk.Coins, readTime int64) sdk.Coins {
+	if readTime >= startTime+periods.TotalLength() {
+		return totalCoins
+	}
+	if readTime <= startTime {
+		return sdk.NewCoins()
+	}
+
+	coins := sdk.NewCoins()
+	periodStartTime := startTime
+	for _, period := range periods {
+		if readTime-periodStartTime < period.Length {
+			break
+		}
+
+		coins = coins.Add(period.Amount...)
+		periodStartTime += period.Length
+	}
+
+	return coins
+}
+
+// capPeriods returns a copy of the periods whose cumulative amounts never
+// exceed the given total. Trailing periods left without coins are dropped.
+func capPeriods(periods Periods, total sdk.Coins) Periods {
+	remaining := total
+	capped := make(Periods, 0, len(periods))
+	for _, period := range periods {
+		amount := CoinsMin(period.Amount, remaining)
+		remaining = remaining.Sub(amount)
+		capped = append(capped, Period{Length: period.Length, Amount: amount})
+	}
+
+	for len(capped) > 0 && capped[len(capped)-1].Amount.IsZero() {
+		capped = capped[:len(capped)-1]
+	}
+
+	return capped
+}
+
+// CoinsMin returns the minimum of each denom of the two sets of coins.
+func CoinsMin(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coinA := range a {
+		amt := sdk.MinInt(coinA.Amount, b.AmountOf(coinA.Denom))
+		if amt.IsPositive() {
+			min = min.Add(sdk.NewCoin(coinA.Denom, amt))
+		}
+	}
+
+	return min
+}
+
+// DisjunctPeriods returns the union of two vesting schedules starting at
+// startP and startQ respectively. The returned schedule starts at the earliest
+// of both start times and releases the coins of both schedules at their
+// original times, combining the events happening at the same time.
+func DisjunctPeriods(startP, startQ int64, p, q []Period) (startTime, endTime int64, merged []Period) {
+	type event struct {
+		time   int64
+		amount sdk.Coins
+	}
+
+	toEvents := func(start int64, periods []Period) []event {
+		events := make([]event, 0, len(periods))
+		time := start
+		for _, period := range periods {
+			time += period.Length
+			events = append(events, event{time: time, amount: period.Amount})
+		}
+		return events
+	}
+
+	startTime = startP
+	if startQ < startTime {
+		startTime = startQ
+	}
+
+	eventsP, eventsQ := toEvents(startP, p), toEvents(startQ, q)
+	merged = make([]Period, 0, len(p)+len(q))
+	endTime = startTime
+	appendEvent := func(e event) {
+		if len(merged) > 0 && e.time == endTime {
+			last := &merged[len(merged)-1]
+			last.Amount = last.Amount.Add(e.amount...)
+			return
+		}
+		merged = append(merged, Period{Length: e.time - endTime, Amount: e.amount})
+		endTime = e.time
+	}
+
+	i, j := 0, 0
+	for i < len(eventsP) || j < len(eventsQ) {
+		if j == len(eventsQ) || (i < len(eventsP) && eventsP[i].time <= eventsQ[j].time) {
+			appendEvent(eventsP[i])
+			i++
+		} else {
+			appendEvent(eventsQ[j])
+			j++
+		}
+	}
+
+	return startTime, endTime, merged
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -174,6 +176,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -400,6 +404,19 @@ func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, balance,
 	pva.BaseVestingAccount.TrackDelegation(balance, pva.GetVestingCoins(blockTime), amount)
 }
 
+// AddGrant merges a new periodic vesting grant starting at grantStartTime into
+// the account. The grant coins are added to the original vesting coins and the
+// vesting events of both schedules keep their original times. The delegated
+// coins are left untouched since the new coins are still vesting.
+func (pva *PeriodicVestingAccount) AddGrant(grantStartTime int64, grantPeriods []Period) {
+	startTime, endTime, periods := DisjunctPeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantPeriods)
+
+	pva.OriginalVesting = pva.OriginalVesting.Add(Periods(grantPeriods).TotalAmount()...)
+	pva.StartTime = startTime
+	pva.EndTime = endTime
+	pva.VestingPeriods = periods
+}
+
 // GetStartTime returns the time when vesting starts for a periodic vesting
 // account.
 func (pva PeriodicVestingAccount) GetStartTime() int64 {
@@ -593,6 +610,182 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by
+// funder. An empty lockup or vesting schedule releases all the coins at the
+// start time.
+//nolint:interfacer
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins,
+	startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength()),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetUnlockedOnly returns the coins released by the lockup schedule only,
+// regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.LockupPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins released by the vesting schedule only,
+// regardless of the lockup schedule. Those coins can no longer be clawed back.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedCoins returns the coins which are both vested and unlocked, i.e.
+// the coins which are owned by the account and can be freely transferred.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return CoinsMin(va.GetUnlockedOnly(blockTime), va.GetVestedOnly(blockTime))
+}
+
+// GetVestingCoins returns the coins which are still locked or not vested yet.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// ComputeClawback removes all the future vesting events from the account and
+// returns the coins which have not vested at clawbackTime. The lockup schedule
+// is capped to the coins which remain in the schedule, so that vested coins
+// stay locked until they would have been unlocked. Delegation bookkeeping is
+// left untouched, see TrackClawbackUndelegation.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	vested := va.GetVestedOnly(time.Unix(clawbackTime, 0))
+	unvested := va.OriginalVesting.Sub(vested)
+	if unvested.IsZero() {
+		return unvested
+	}
+
+	va.OriginalVesting = vested
+	va.LockupPeriods = capPeriods(va.LockupPeriods, vested)
+	va.VestingPeriods = capPeriods(va.VestingPeriods, vested)
+	va.EndTime = va.StartTime + max64(Periods(va.LockupPeriods).TotalLength(), Periods(va.VestingPeriods).TotalLength())
+
+	return unvested
+}
+
+// TrackClawbackUndelegation tracks the delegated coins which were transferred
+// away from the account by a clawback. Since those coins leave the staking
+// module without being undelegated to the account, they are removed from the
+// delegated coins and the remaining delegated coins are split again between
+// delegated vesting and delegated free coins against the vesting coins of the
+// updated schedule.
+func (va *ClawbackVestingAccount) TrackClawbackUndelegation(blockTime time.Time, amount sdk.Coins) {
+	delegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	delegated = delegated.Sub(CoinsMin(delegated, amount))
+
+	va.DelegatedVesting = CoinsMin(delegated, va.GetVestingCoins(blockTime))
+	va.DelegatedFree = delegated.Sub(va.DelegatedVesting)
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	lockupPeriods, vestingPeriods := Periods(va.LockupPeriods), Periods(va.VestingPeriods)
+	for _, periods := range []Periods{lockupPeriods, vestingPeriods} {
+		for _, p := range periods {
+			if p.Length < 0 {
+				return errors.New("period length cannot be negative")
+			}
+		}
+	}
+	endTime := va.StartTime + max64(lockupPeriods.TotalLength(), vestingPeriods.TotalLength())
+	if endTime != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !lockupPeriods.TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !vestingPeriods.TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
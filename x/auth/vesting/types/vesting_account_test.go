@@ -547,6 +547,99 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestDisjunctPeriods(t *testing.T) {
+	fee := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(feeDenom, amt)} }
+	stake := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amt)} }
+
+	testCases := []struct {
+		name         string
+		startP       int64
+		p            []types.Period
+		startQ       int64
+		q            []types.Period
+		expStartTime int64
+		expEndTime   int64
+		expPeriods   []types.Period
+	}{
+		{
+			"empty grant",
+			100, []types.Period{{Length: 10, Amount: fee(10)}},
+			50, nil,
+			50, 110, []types.Period{{Length: 60, Amount: fee(10)}},
+		},
+		{
+			"interleaved",
+			100, []types.Period{{Length: 10, Amount: fee(10)}, {Length: 10, Amount: fee(10)}},
+			105, []types.Period{{Length: 10, Amount: stake(5)}, {Length: 10, Amount: stake(5)}},
+			100, 125, []types.Period{
+				{Length: 10, Amount: fee(10)},
+				{Length: 5, Amount: stake(5)},
+				{Length: 5, Amount: fee(10)},
+				{Length: 5, Amount: stake(5)},
+			},
+		},
+		{
+			"simultaneous events",
+			100, []types.Period{{Length: 10, Amount: fee(10)}},
+			90, []types.Period{{Length: 20, Amount: stake(5)}, {Length: 10, Amount: stake(5)}},
+			90, 120, []types.Period{
+				{Length: 20, Amount: fee(10).Add(stake(5)...)},
+				{Length: 10, Amount: stake(5)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			startTime, endTime, periods := types.DisjunctPeriods(tc.startP, tc.startQ, tc.p, tc.q)
+			require.Equal(t, tc.expStartTime, startTime)
+			require.Equal(t, tc.expEndTime, endTime)
+			require.Equal(t, tc.expPeriods, periods)
+		})
+	}
+}
+
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	grant := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		types.Period{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}},
+	}
+	grantCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+
+	// the grant starts 6 hours after the original schedule
+	pva.AddGrant(now.Add(6*time.Hour).Unix(), grant)
+	require.NoError(t, pva.Validate())
+	require.Equal(t, origCoins.Add(grantCoins...), pva.OriginalVesting)
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(36*time.Hour).Unix(), pva.EndTime)
+
+	// require the events of both schedules to keep their time
+	require.Equal(t, origCoins.Add(grantCoins...), pva.LockedCoins(now))
+	require.Equal(t, origCoins.Add(grantCoins...), pva.LockedCoins(now.Add(11*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 80)}, pva.LockedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 80)}, pva.LockedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, pva.LockedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), pva.LockedCoins(now.Add(36*time.Hour)))
+
+	// require delegated coins to stay untouched by a grant
+	pva = types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	pva.TrackDelegation(now.Add(24*time.Hour), origCoins, origCoins)
+	require.Equal(t, origCoins, pva.DelegatedFree)
+	pva.AddGrant(now.Add(24*time.Hour).Unix(), grant)
+	require.Equal(t, origCoins, pva.DelegatedFree)
+	require.Nil(t, pva.DelegatedVesting)
+	require.Equal(t, grantCoins, pva.LockedCoins(now.Add(24*time.Hour)))
+}
+
 func TestGetVestedCoinsPermLockedVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(1000 * 24 * time.Hour)
@@ -676,6 +769,169 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(18*time.Hour).Unix(), va.EndTime)
+
+	// require no coins vested nor unlocked at the beginning of the schedules
+	require.Empty(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// require vested coins to stay locked until the lockup period is over
+	require.Equal(t, vestingPeriods[0].Amount, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.Empty(t, va.GetUnlockedOnly(now.Add(6*time.Hour)))
+	require.Empty(t, va.GetVestedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, origCoins, va.LockedCoins(now.Add(6*time.Hour)))
+
+	// require unlocked coins to stay vesting until the vesting period is over
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, vestingPeriods[0].Amount, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, vestingPeriods[1].Amount, va.GetVestingCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of both schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Empty(t, va.LockedCoins(now.Add(18*time.Hour)))
+}
+
+func TestGetVestedCoinsClawbackVestingAccEmptySchedule(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+
+	// require an empty lockup schedule to unlock all coins at the start time
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now))
+	require.Empty(t, va.GetVestedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// require an empty vesting schedule to vest all coins at the start time
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), vestingPeriods, nil)
+	require.NoError(t, va.Validate())
+	require.Equal(t, origCoins, va.GetVestedOnly(now))
+	require.Equal(t, origCoins, va.LockedCoins(now.Add(6*time.Hour)))
+}
+
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+
+	// require all coins to be clawed back before the first vesting event
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, origCoins, va.ComputeClawback(now.Add(time.Hour).Unix()))
+	require.Empty(t, va.OriginalVesting)
+	require.Empty(t, va.LockupPeriods)
+	require.Empty(t, va.VestingPeriods)
+	require.Equal(t, now.Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// require vested coins to be kept and to stay locked
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	unvested := va.ComputeClawback(now.Add(13 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, unvested)
+	require.Equal(t, origCoins.Sub(unvested), va.OriginalVesting)
+	require.Equal(t, []types.Period{vestingPeriods[0], vestingPeriods[1]}, va.VestingPeriods)
+	require.Equal(t, []types.Period{{Length: lockupPeriods[0].Length, Amount: origCoins.Sub(unvested)}}, va.LockupPeriods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.EndTime)
+	require.Equal(t, origCoins.Sub(unvested), va.LockedCoins(now.Add(13*time.Hour)))
+	require.NoError(t, va.Validate())
+
+	// require nothing to be clawed back once everything vested
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Empty(t, va.ComputeClawback(now.Add(18*time.Hour).Unix()))
+	require.Equal(t, origCoins, va.OriginalVesting)
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+
+	// require the ability to delegate all vesting coins
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, va.DelegatedVesting)
+	require.Nil(t, va.DelegatedFree)
+
+	// require delegations of vested coins to be free once unlocked
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+	va.TrackDelegation(now.Add(12*time.Hour), origCoins, origCoins)
+	require.Equal(t, vestingPeriods[1].Amount, va.DelegatedVesting)
+	require.Equal(t, vestingPeriods[0].Amount, va.DelegatedFree)
+
+	// require undelegations to release delegated free coins first
+	va.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, va.DelegatedFree)
+	require.Equal(t, vestingPeriods[1].Amount, va.DelegatedVesting)
+}
+
+func TestTrackClawbackUndelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc, _ := initBaseAccount()
+
+	// delegate everything, then claw back the unvested half from the delegations
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	unvested := va.ComputeClawback(now.Add(12 * time.Hour).Unix())
+	require.Equal(t, vestingPeriods[1].Amount, unvested)
+
+	va.TrackClawbackUndelegation(now.Add(12*time.Hour), unvested)
+	require.Empty(t, va.DelegatedVesting)
+	require.Equal(t, vestingPeriods[0].Amount, va.DelegatedFree)
+
+	// require delegated coins to stay vesting when vested coins are still locked
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), types.Periods{{Length: int64(48 * 60 * 60), Amount: origCoins}}, vestingPeriods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	unvested = va.ComputeClawback(now.Add(12 * time.Hour).Unix())
+	va.TrackClawbackUndelegation(now.Add(12*time.Hour), unvested)
+	require.Equal(t, vestingPeriods[0].Amount, va.DelegatedVesting)
+	require.Empty(t, va.DelegatedFree)
+
+	// require the tracked delegations to never become negative
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+	va.TrackDelegation(now, origCoins, vestingPeriods[0].Amount)
+	va.ComputeClawback(now.Unix())
+	va.TrackClawbackUndelegation(now, origCoins)
+	require.Empty(t, va.DelegatedVesting)
+	require.Empty(t, va.DelegatedFree)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -741,6 +997,26 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, nil, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback vesting lockup amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}, nil),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -828,6 +1104,26 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}}, types.Periods{types.Period{7200, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdFactoryDenom(),
+		GetCmdFactoryDenomsFromCreator(),
 	)
 
 	return cmd
@@ -212,3 +214,74 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdFactoryDenom returns a CLI command handler for querying the admin of a
+// token factory denom.
+func GetCmdFactoryDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "factory-denom [denom]",
+		Short: "Query a token factory denom and its admin",
+		Example: fmt.Sprintf("$ %s query %s factory-denom factory/cosmos1.../utoken",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FactoryDenom(cmd.Context(), &types.QueryFactoryDenomRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFactoryDenomsFromCreator returns a CLI command handler for querying
+// the token factory denoms created by an account.
+func GetCmdFactoryDenomsFromCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "factory-denoms [creator]",
+		Short: "Query the token factory denoms created by an account",
+		Example: fmt.Sprintf("$ %s query %s factory-denoms cosmos1...",
+			version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FactoryDenomsFromCreator(cmd.Context(), &types.QueryFactoryDenomsFromCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "factory denoms")
+
+	return cmd
+}
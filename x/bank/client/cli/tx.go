@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,7 +23,14 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewSendTxCmd())
+	txCmd.AddCommand(
+		NewSendTxCmd(),
+		NewCreateDenomTxCmd(),
+		NewMintTxCmd(),
+		NewBurnTxCmd(),
+		NewChangeAdminTxCmd(),
+		NewSetDenomMetadataTxCmd(),
+	)
 
 	return txCmd
 }
@@ -58,3 +68,167 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// NewCreateDenomTxCmd returns a CLI command handler for creating a
+// MsgCreateDenom transaction.
+func NewCreateDenomTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-denom [subdenom]",
+		Short: `Create the factory/{creator}/{subdenom} denom, administered by the creator.
+The denom creation fee is paid to the community pool.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMintTxCmd returns a CLI command handler for creating a MsgMint
+// transaction.
+func NewMintTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount]",
+		Short: "Mint factory coins to the admin of the denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn
+// transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn factory coins from the balance of the admin of the denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChangeAdminTxCmd returns a CLI command handler for creating a
+// MsgChangeAdmin transaction.
+func NewChangeAdminTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "change-admin [denom] [new_admin_address]",
+		Short: `Change the admin of a factory denom. An empty new admin address ("")
+renounces the administration of the denom for good.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var newAdmin sdk.AccAddress
+			if args[1] != "" {
+				newAdmin, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress(), args[0], newAdmin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetDenomMetadataTxCmd returns a CLI command handler for creating a
+// MsgSetDenomMetadata transaction.
+func NewSetDenomMetadataTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata_file]",
+		Short: "Update the metadata of a factory denom from a JSON file",
+		Long: `Update the metadata of the factory denom given by the base of the metadata,
+read from a JSON file such as:
+
+{
+  "description": "My token",
+  "denom_units": [
+    {"denom": "factory/cosmos1.../utoken", "exponent": 0},
+    {"denom": "token", "exponent": 6}
+  ],
+  "base": "factory/cosmos1.../utoken",
+  "display": "token",
+  "name": "Token",
+  "symbol": "TKN"
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &metadata); err != nil {
+				return fmt.Errorf("failed to parse metadata: %w", err)
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress(), metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
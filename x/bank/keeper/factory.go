@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// communityPool holds the distribution keeper used to fund the community
+// pool. It is shared by all copies of the keeper, as x/distribution depends on
+// x/bank and is therefore created after it.
+type communityPool struct {
+	dk types.DistributionKeeper
+}
+
+// SetDistributionKeeper sets the distribution keeper which receives the token
+// factory denom creation fees in its community pool. It panics if called
+// twice.
+func (k BaseKeeper) SetDistributionKeeper(dk types.DistributionKeeper) {
+	if k.communityPool.dk != nil {
+		panic("cannot set distribution keeper twice")
+	}
+
+	k.communityPool.dk = dk
+}
+
+// CreateFactoryDenom creates the factory/{creator}/{subdenom} denom with the
+// creator as admin, and charges the creator the denom creation fee, which is
+// sent to the community pool. The denom metadata is initialized with a single
+// denom unit, the denom as name and the subdenom as symbol.
+func (k BaseKeeper) CreateFactoryDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetFactoryDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.GetFactoryDenom(ctx, denom); found || k.HasDenomMetaData(ctx, denom) || k.HasSupply(ctx, denom) {
+		return "", types.ErrFactoryDenomExists.Wrap(denom)
+	}
+
+	fee := k.GetParams(ctx).DenomCreationFee
+	if !fee.IsZero() {
+		if k.communityPool.dk == nil {
+			return "", sdkerrors.ErrLogic.Wrap("denom creation fee is set but no community pool is available")
+		}
+		if err := k.communityPool.dk.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", sdkerrors.Wrap(err, "failed to pay denom creation fee")
+		}
+	}
+
+	k.SetDenomMetaData(ctx, types.Metadata{
+		DenomUnits: []*types.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     subdenom,
+	})
+	k.setFactoryDenom(ctx, types.NewFactoryDenom(denom, creator))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return denom, nil
+}
+
+// MintFactoryCoins mints the given factory coins to the admin of their denom.
+func (k BaseKeeper) MintFactoryCoins(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if err := k.addCoins(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amount.Denom)
+	k.setSupply(ctx, supply.Add(amount))
+
+	ctx.EventManager().EmitEvent(
+		types.NewCoinMintEvent(admin, amounts),
+	)
+
+	return nil
+}
+
+// BurnFactoryCoins burns the given factory coins from the balance of the admin
+// of their denom.
+func (k BaseKeeper) BurnFactoryCoins(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if err := k.subUnlockedCoins(ctx, admin, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amount.Denom)
+	k.setSupply(ctx, supply.Sub(amount))
+
+	ctx.EventManager().EmitEvent(
+		types.NewCoinBurnEvent(admin, amounts),
+	)
+
+	return nil
+}
+
+// ChangeFactoryDenomAdmin sets the admin of a factory denom. An empty new
+// admin leaves the denom without admin, so that it can no longer be changed.
+func (k BaseKeeper) ChangeFactoryDenomAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+
+	fd := types.NewFactoryDenom(denom, newAdmin)
+	k.setFactoryDenom(ctx, fd)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeDenomAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, fd.Admin),
+		),
+	)
+
+	return nil
+}
+
+// SetFactoryDenomMetadata replaces the metadata of the factory denom given by
+// the metadata base.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx sdk.Context, admin sdk.AccAddress, metadata types.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.assertFactoryDenomAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+		),
+	)
+
+	return nil
+}
+
+// GetFactoryDenom returns the factory denom and its admin, if it exists.
+func (k BaseKeeper) GetFactoryDenom(ctx sdk.Context, denom string) (types.FactoryDenom, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FactoryAdminPrefix)
+
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.FactoryDenom{}, false
+	}
+
+	var fd types.FactoryDenom
+	k.cdc.MustUnmarshal(bz, &fd)
+
+	return fd, true
+}
+
+// IterateFactoryDenoms iterates over all the factory denoms and calls the
+// provided callback. Iteration stops when the callback returns true.
+func (k BaseKeeper) IterateFactoryDenoms(ctx sdk.Context, cb func(types.FactoryDenom) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FactoryAdminPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fd types.FactoryDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &fd)
+
+		if cb(fd) {
+			break
+		}
+	}
+}
+
+// GetAllFactoryDenoms returns all the factory denoms.
+func (k BaseKeeper) GetAllFactoryDenoms(ctx sdk.Context) []types.FactoryDenom {
+	factoryDenoms := make([]types.FactoryDenom, 0)
+	k.IterateFactoryDenoms(ctx, func(fd types.FactoryDenom) bool {
+		factoryDenoms = append(factoryDenoms, fd)
+		return false
+	})
+
+	return factoryDenoms
+}
+
+// setFactoryDenom stores the factory denom and its admin.
+func (k BaseKeeper) setFactoryDenom(ctx sdk.Context, fd types.FactoryDenom) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FactoryAdminPrefix)
+	store.Set([]byte(fd.Denom), k.cdc.MustMarshal(&fd))
+}
+
+// assertFactoryDenomAdmin returns an error if the denom is not a factory denom
+// administered by admin.
+func (k BaseKeeper) assertFactoryDenomAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string) error {
+	fd, found := k.GetFactoryDenom(ctx, denom)
+	if !found {
+		return types.ErrFactoryDenomNotFound.Wrap(denom)
+	}
+
+	if !admin.Equals(fd.AdminAddress()) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not the admin of %s", admin, denom)
+	}
+
+	return nil
+}
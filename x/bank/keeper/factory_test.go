@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func (suite *IntegrationTestSuite) TestCreateFactoryDenom() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	creator := sdk.AccAddress("creator_____________")
+	fee := sdk.NewCoins(newFooCoin(10))
+	params := app.BankKeeper.GetParams(ctx)
+	params.DenomCreationFee = fee
+	app.BankKeeper.SetParams(ctx, params)
+
+	// the creator cannot pay the fee
+	_, err := msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(creator, "utoken"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, creator, sdk.NewCoins(newFooCoin(15))))
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	res, err := msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(creator, "utoken"))
+	suite.Require().NoError(err)
+	denom := "factory/" + creator.String() + "/utoken"
+	suite.Require().Equal(denom, res.NewTokenDenom)
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(5)), app.BankKeeper.GetAllBalances(ctx, creator))
+	suite.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(fee...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(denom, metadata.Base)
+	suite.Require().NoError(metadata.Validate())
+
+	fd, found := app.BankKeeper.GetFactoryDenom(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewFactoryDenom(denom, creator), fd)
+
+	// the denom cannot be created twice
+	_, err = msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(creator, "utoken"))
+	suite.Require().ErrorIs(err, types.ErrFactoryDenomExists)
+
+	params.DenomCreationFee = sdk.NewCoins()
+	app.BankKeeper.SetParams(ctx, params)
+	_, err = msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(creator, "other"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(5)), app.BankKeeper.GetAllBalances(ctx, creator))
+
+	queryRes, err := suite.queryClient.FactoryDenom(sdk.WrapSDKContext(ctx), &types.QueryFactoryDenomRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fd, queryRes.FactoryDenom)
+
+	_, err = suite.queryClient.FactoryDenom(sdk.WrapSDKContext(ctx), &types.QueryFactoryDenomRequest{Denom: fooDenom})
+	suite.Require().Error(err)
+
+	creatorRes, err := suite.queryClient.FactoryDenomsFromCreator(sdk.WrapSDKContext(ctx), &types.QueryFactoryDenomsFromCreatorRequest{Creator: creator.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(creatorRes.FactoryDenoms, 2)
+	suite.Require().Equal("factory/"+creator.String()+"/other", creatorRes.FactoryDenoms[0].Denom)
+	suite.Require().Equal(denom, creatorRes.FactoryDenoms[1].Denom)
+
+	creatorRes, err = suite.queryClient.FactoryDenomsFromCreator(sdk.WrapSDKContext(ctx), &types.QueryFactoryDenomsFromCreatorRequest{Creator: sdk.AccAddress("other_______________").String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(creatorRes.FactoryDenoms)
+}
+
+func (suite *IntegrationTestSuite) TestFactoryMintBurn() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+	denom, err := app.BankKeeper.CreateFactoryDenom(ctx, admin, "utoken")
+	suite.Require().NoError(err)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(other, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin("factory/"+admin.String()+"/unknown", 100)))
+	suite.Require().ErrorIs(err, types.ErrFactoryDenomNotFound)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetBalance(ctx, admin, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), app.BankKeeper.GetSupply(ctx, denom))
+
+	// minted coins can be sent like any other coin
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, admin, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 30))))
+
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(other, sdk.NewInt64Coin(denom, 30)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 80)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 50)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 20), app.BankKeeper.GetBalance(ctx, admin, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 30), app.BankKeeper.GetBalance(ctx, other, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 50), app.BankKeeper.GetSupply(ctx, denom))
+
+	genState := app.BankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.FactoryDenom{types.NewFactoryDenom(denom, admin)}, genState.FactoryDenoms)
+	suite.Require().NoError(genState.Validate())
+}
+
+func (suite *IntegrationTestSuite) TestFactoryChangeAdminAndMetadata() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	newAdmin := sdk.AccAddress("newAdmin____________")
+	denom, err := app.BankKeeper.CreateFactoryDenom(ctx, admin, "utoken")
+	suite.Require().NoError(err)
+
+	metadata := types.Metadata{
+		Description: "The token",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "token",
+		Name:    "Token",
+		Symbol:  "TKN",
+	}
+
+	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(newAdmin, denom, newAdmin))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(admin, denom, newAdmin))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), types.NewMsgSetDenomMetadata(admin, metadata))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), types.NewMsgSetDenomMetadata(newAdmin, metadata))
+	suite.Require().NoError(err)
+	stored, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata, stored)
+
+	// the metadata of a coin which is not a factory denom cannot be set
+	metadata.Base = fooDenom
+	metadata.DenomUnits[0].Denom = fooDenom
+	_, err = msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), types.NewMsgSetDenomMetadata(newAdmin, metadata))
+	suite.Require().ErrorIs(err, types.ErrFactoryDenomNotFound)
+
+	// renouncing the administration freezes the denom
+	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(newAdmin, denom, nil))
+	suite.Require().NoError(err)
+	fd, found := app.BankKeeper.GetFactoryDenom(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Empty(fd.Admin)
+
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(newAdmin, sdk.NewInt64Coin(denom, 100)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), types.NewMsgChangeAdmin(newAdmin, denom, newAdmin))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *IntegrationTestSuite) TestMigrate3to4() {
+	app, ctx := suite.app, suite.ctx
+
+	// remove the param as in a store of consensus version 3
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	paramsStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyDenomCreationFee...))
+	suite.Require().Panics(func() { app.BankKeeper.GetParams(ctx) })
+
+	suite.Require().NoError(keeper.NewMigrator(app.BankKeeper.(keeper.BaseKeeper)).Migrate3to4(ctx))
+	suite.Require().True(types.DefaultDenomCreationFee.IsEqual(app.BankKeeper.GetParams(ctx).DenomCreationFee))
+}
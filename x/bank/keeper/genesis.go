@@ -39,6 +39,10 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, fd := range genState.FactoryDenoms {
+		k.setFactoryDenom(ctx, fd)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -48,10 +52,13 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+	genState.FactoryDenoms = k.GetAllFactoryDenoms(ctx)
+
+	return genState
 }
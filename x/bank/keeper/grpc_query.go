@@ -210,3 +210,55 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// FactoryDenom implements the Query/FactoryDenom gRPC method.
+func (k BaseKeeper) FactoryDenom(goCtx context.Context, req *types.QueryFactoryDenomRequest) (*types.QueryFactoryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fd, found := k.GetFactoryDenom(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "factory denom %s", req.Denom)
+	}
+
+	return &types.QueryFactoryDenomResponse{FactoryDenom: fd}, nil
+}
+
+// FactoryDenomsFromCreator implements the Query/FactoryDenomsFromCreator gRPC
+// method.
+func (k BaseKeeper) FactoryDenomsFromCreator(goCtx context.Context, req *types.QueryFactoryDenomsFromCreatorRequest) (*types.QueryFactoryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateFactoryAdminPrefix(creator.String()))
+
+	factoryDenoms := []types.FactoryDenom{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var fd types.FactoryDenom
+		if err := k.cdc.Unmarshal(value, &fd); err != nil {
+			return err
+		}
+
+		factoryDenoms = append(factoryDenoms, fd)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFactoryDenomsFromCreatorResponse{FactoryDenoms: factoryDenoms, Pagination: pageRes}, nil
+}
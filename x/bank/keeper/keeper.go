@@ -45,6 +45,15 @@ type Keeper interface {
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	SetDistributionKeeper(dk types.DistributionKeeper)
+	CreateFactoryDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	MintFactoryCoins(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error
+	BurnFactoryCoins(ctx sdk.Context, admin sdk.AccAddress, amount sdk.Coin) error
+	ChangeFactoryDenomAdmin(ctx sdk.Context, admin sdk.AccAddress, denom string, newAdmin sdk.AccAddress) error
+	SetFactoryDenomMetadata(ctx sdk.Context, admin sdk.AccAddress, metadata types.Metadata) error
+	GetFactoryDenom(ctx sdk.Context, denom string) (types.FactoryDenom, bool)
+	IterateFactoryDenoms(ctx sdk.Context, cb func(types.FactoryDenom) (stop bool))
+
 	types.QueryServer
 }
 
@@ -56,6 +65,8 @@ type BaseKeeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
 	paramSpace paramtypes.Subspace
+
+	communityPool *communityPool
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -108,6 +119,7 @@ func NewBaseKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
+		communityPool:  &communityPool{},
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -457,6 +458,98 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	frozen := func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if fromAddr.Equals(addr1) && toAddr.Equals(addr3) {
+			return toAddr, sdkerrors.ErrUnauthorized.Wrap("recipient is frozen")
+		}
+		return toAddr, nil
+	}
+	redirect := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	}
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	app.BankKeeper.AppendSendRestriction(frozen)
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr3, sendAmt), sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// a redirection appended after the check is not checked
+	app.BankKeeper.AppendSendRestriction(redirect)
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// a redirection prepended before the check is rejected
+	app.BankKeeper.ClearSendRestriction()
+	app.BankKeeper.AppendSendRestriction(frozen)
+	app.BankKeeper.PrependSendRestriction(redirect)
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt), sdkerrors.ErrUnauthorized)
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sendAmt}}
+	outputs := []types.Output{{Address: addr2.String(), Coins: sendAmt}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+
+	app.BankKeeper.ClearSendRestriction()
+	app.BankKeeper.AppendSendRestriction(redirect)
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sendAmt.Add(sendAmt...), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// the restrictions are applied to the outputs once per input
+	addr4 := sdk.AccAddress("addr4_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr4, balances))
+	var senders []string
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		senders = append(senders, fromAddr.String())
+		return toAddr, nil
+	})
+	twoInputs := []types.Input{{Address: addr4.String(), Coins: sendAmt}, {Address: addr1.String(), Coins: sendAmt}}
+	twoOutputs := []types.Output{{Address: addr2.String(), Coins: sendAmt.Add(sendAmt...)}}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, twoInputs, twoOutputs))
+	suite.Require().Equal([]string{addr4.String(), addr1.String()}, senders)
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// a restriction rejecting any of the inputs rejects the multi-send
+	app.BankKeeper.AppendSendRestriction(frozen)
+	cacheCtx, _ := ctx.CacheContext()
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(cacheCtx, twoInputs, twoOutputs), sdkerrors.ErrUnauthorized)
+
+	// transfers cannot be redirected to blocked addresses
+	app.BankKeeper.ClearSendRestriction()
+	blocked := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blocked))
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return blocked, nil
+	})
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt), sdkerrors.ErrUnauthorized)
+
+	// module to account transfers are restricted as well
+	app.BankKeeper.ClearSendRestriction()
+	app.BankKeeper.AppendSendRestriction(redirect)
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sendAmt))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sendAmt))
+	suite.Require().Empty(app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -27,6 +27,10 @@ type SendKeeper interface {
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,6 +47,11 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// sendRestriction is shared by all copies of the keeper so that
+	// restrictions registered after the keeper is wired into the app apply
+	// everywhere.
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
@@ -50,15 +59,33 @@ func NewBaseSendKeeper(
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		sendRestriction: newSendRestriction(),
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously registered restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before the
+// previously registered restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes all the registered send restrictions.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -80,11 +107,13 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
+		inAddresses[i] = inAddress
 
 		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
 		if err != nil {
@@ -104,6 +133,24 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+
+		// The outputs are not tied to the inputs, so the send restrictions are
+		// applied to each output once per input, each input seeing the
+		// recipient the previous ones may have redirected the output to.
+		newOutAddress := outAddress
+		for _, inAddress := range inAddresses {
+			newOutAddress, err = k.sendRestriction.apply(ctx, inAddress, newOutAddress, out.Coins)
+			if err != nil {
+				return err
+			}
+		}
+		// The recipients of the outputs were checked by the caller, but not
+		// the ones the send restrictions redirected the outputs to.
+		if !newOutAddress.Equals(outAddress) && k.BlockedAddr(newOutAddress) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newOutAddress)
+		}
+		outAddress = newOutAddress
+
 		err = k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
@@ -112,7 +159,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -132,9 +179,21 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The registered send restrictions may reject the transfer or change its
+// recipient. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	// The recipient was checked by the caller, but not the one the send
+	// restrictions redirected the transfer to.
+	if !newToAddr.Equals(toAddr) && k.BlockedAddr(newToAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newToAddr)
+	}
+	toAddr = newToAddr
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -323,3 +382,40 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// sendRestriction holds the composed SendRestrictionFn registered on the
+// keeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{}
+}
+
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// registered returns whether any send restriction is registered.
+func (r *sendRestriction) registered() bool {
+	return r != nil && r.fn != nil
+}
+
+// apply runs the send restriction, if any, and returns the recipient of the
+// transfer.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if !r.registered() {
+		return toAddr, nil
+	}
+
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
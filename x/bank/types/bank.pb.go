@@ -31,6 +31,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denom_creation_fee is the fee paid to the community pool to create a
+	// token factory denom.
+	//
+	// Since: cosmos-sdk 0.46
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,6 +84,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -441,6 +453,64 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// FactoryDenom defines a denom created by the token factory and its admin.
+//
+// Since: cosmos-sdk 0.46
+type FactoryDenom struct {
+	// denom is the factory denom, of the form factory/{creator}/{subdenom}.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the account allowed to mint and burn the denom, and to update its
+	// metadata. An empty admin means that the denom can no longer be changed.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
+}
+func (m *FactoryDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
+
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FactoryDenom) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -449,53 +519,57 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x3a, 0xed, 0x61, 0x13, 0xf6, 0x20, 0xb1,
-	0xd0, 0x24, 0xad, 0x9e, 0x82, 0x20, 0xb6, 0x8a, 0x46, 0x10, 0x65, 0x4b, 0x11, 0xbc, 0x84, 0x49,
-	0x76, 0x4c, 0x86, 0xee, 0xce, 0x2c, 0x3b, 0xb3, 0xa5, 0xb9, 0x0a, 0x82, 0x78, 0xf2, 0xe8, 0xb1,
-	0x57, 0x3d, 0x79, 0x28, 0xf8, 0x17, 0x8a, 0xa7, 0xe2, 0xc9, 0x53, 0x95, 0xf4, 0xa0, 0x3f, 0x43,
-	0x66, 0x66, 0x37, 0x4d, 0xa1, 0x8a, 0x07, 0x0f, 0x9e, 0xf6, 0x7d, 0xef, 0x7b, 0xf3, 0xbd, 0xef,
-	0xed, 0xbc, 0x5d, 0xe8, 0x0e, 0x85, 0x8c, 0x84, 0x6c, 0x0f, 0x08, 0xdf, 0x6d, 0xef, 0xad, 0x0f,
-	0xa8, 0x22, 0xeb, 0x06, 0xb4, 0xe2, 0x44, 0x28, 0x81, 0xae, 0x58, 0xbe, 0x65, 0x52, 0x19, 0xbf,
-	0x52, 0x1b, 0x89, 0x91, 0x30, 0x7c, 0x5b, 0x47, 0xb6, 0x74, 0x65, 0xd9, 0x96, 0xf6, 0x2d, 0x91,
-	0x9d, 0xb3, 0xd4, 0x59, 0x17, 0x49, 0x67, 0x5d, 0x86, 0x82, 0xf1, 0x8c, 0xbf, 0x96, 0xf1, 0x91,
-	0x1c, 0xb5, 0xf7, 0xd6, 0xf5, 0xc3, 0x12, 0xde, 0x2b, 0x00, 0xcb, 0x4f, 0x49, 0x42, 0x22, 0x89,
-	0xb6, 0xe0, 0x25, 0x49, 0x79, 0xd0, 0xa7, 0x9c, 0x0c, 0x42, 0x1a, 0x60, 0xd0, 0x28, 0x36, 0xab,
-	0x1b, 0x8d, 0xd6, 0x05, 0x06, 0x5b, 0xdb, 0x94, 0x07, 0xf7, 0x6d, 0x9d, 0x5f, 0x95, 0x67, 0x00,
-	0x75, 0x60, 0x2d, 0xa0, 0x2f, 0x48, 0x1a, 0xaa, 0xfe, 0x39, 0xb1, 0x85, 0x06, 0x68, 0x3a, 0x3e,
-	0xca, 0xb8, 0xb9, 0xe3, 0xdd, 0xc5, 0x77, 0x07, 0xf5, 0x82, 0xf7, 0x00, 0x56, 0xe7, 0x92, 0xa8,
-	0x06, 0x4b, 0x01, 0xe5, 0x22, 0xc2, 0xa0, 0x01, 0x9a, 0x4b, 0xbe, 0x05, 0x08, 0xc3, 0xca, 0x79,
-	0xbd, 0x1c, 0x76, 0x1d, 0x2d, 0xf2, 0xf3, 0xa0, 0x0e, 0xbc, 0x43, 0x00, 0x4b, 0x3d, 0x1e, 0xa7,
-	0x0a, 0x6d, 0xc0, 0x0a, 0x09, 0x82, 0x84, 0x4a, 0x69, 0x55, 0x36, 0xf1, 0x97, 0xc3, 0xb5, 0x5a,
-	0x36, 0xcd, 0x5d, 0xcb, 0x6c, 0xab, 0x84, 0xf1, 0x91, 0x9f, 0x17, 0x22, 0x02, 0x4b, 0xfa, 0xad,
-	0x49, 0xbc, 0x60, 0x86, 0x5f, 0x3e, 0x1b, 0x5e, 0xd2, 0xd9, 0xf0, 0x5b, 0x82, 0xf1, 0xcd, 0xce,
-	0xd1, 0x49, 0xbd, 0xf0, 0xe1, 0x5b, 0xbd, 0x39, 0x62, 0x6a, 0x9c, 0x0e, 0x5a, 0x43, 0x11, 0x65,
-	0x57, 0x92, 0x3d, 0xd6, 0x64, 0xb0, 0xdb, 0x56, 0x93, 0x98, 0x4a, 0x73, 0x40, 0xfa, 0x56, 0xb9,
-	0x5b, 0x7b, 0x6d, 0xad, 0x16, 0x5e, 0xfe, 0xf8, 0xb8, 0x9a, 0x37, 0xf6, 0xde, 0x03, 0x58, 0x7e,
-	0x92, 0xaa, 0xff, 0xd8, 0xb7, 0x93, 0xfb, 0xf6, 0x3e, 0x01, 0x58, 0xde, 0x4e, 0xe3, 0x38, 0x9c,
-	0xe8, 0xbe, 0x4a, 0x28, 0x12, 0x66, 0xcb, 0xf2, 0x6f, 0xfb, 0x1a, 0xe5, 0xee, 0xa3, 0xac, 0x2f,
-	0xf8, 0x7c, 0xb8, 0x76, 0x7b, 0xf5, 0x8f, 0xa7, 0xf7, 0xed, 0x57, 0x16, 0xb1, 0x51, 0x42, 0x14,
-	0x13, 0x5c, 0xb6, 0xf7, 0x3a, 0xb7, 0x3a, 0x2d, 0xeb, 0xb5, 0x87, 0x81, 0xf7, 0x0c, 0x2e, 0xdd,
-	0xd3, 0x9b, 0xb4, 0xc3, 0x99, 0xfa, 0xcd, 0x8e, 0xad, 0x40, 0x87, 0xee, 0xc7, 0x82, 0x53, 0xae,
-	0xcc, 0x92, 0x5d, 0xf6, 0x67, 0x58, 0xef, 0x1f, 0x09, 0x19, 0x91, 0x54, 0xe2, 0x62, 0xa3, 0xd8,
-	0x5c, 0xf2, 0x73, 0xe8, 0xbd, 0x59, 0x80, 0xce, 0x63, 0xaa, 0x48, 0x40, 0x14, 0x41, 0x0d, 0x58,
-	0x0d, 0xa8, 0x1c, 0x26, 0x2c, 0xd6, 0x26, 0x32, 0xf9, 0xf9, 0x14, 0xba, 0xa3, 0x2b, 0xb8, 0x88,
-	0xfa, 0x29, 0x67, 0x2a, 0xbf, 0x34, 0xf7, 0xc2, 0x2f, 0x6d, 0xe6, 0xd7, 0x87, 0x41, 0x1e, 0x4a,
-	0x84, 0xe0, 0xa2, 0x7e, 0xc5, 0xb8, 0x68, 0xb4, 0x4d, 0xac, 0xdd, 0x05, 0x4c, 0xc6, 0x21, 0x99,
-	0xe0, 0x45, 0x93, 0xce, 0xa1, 0xae, 0xe6, 0x24, 0xa2, 0xb8, 0x64, 0xab, 0x75, 0x8c, 0xae, 0xc2,
-	0xb2, 0x9c, 0x44, 0x03, 0x11, 0xe2, 0xb2, 0xc9, 0x66, 0x08, 0x2d, 0xc3, 0x62, 0x9a, 0x30, 0x5c,
-	0x31, 0x9b, 0x57, 0x99, 0x9e, 0xd4, 0x8b, 0x3b, 0x7e, 0xcf, 0xd7, 0x39, 0x74, 0x1d, 0x3a, 0x69,
-	0xc2, 0xfa, 0x63, 0x22, 0xc7, 0xd8, 0x31, 0x7c, 0x75, 0x7a, 0x52, 0xaf, 0xec, 0xf8, 0xbd, 0x87,
-	0x44, 0x8e, 0xfd, 0x4a, 0x9a, 0x30, 0x1d, 0x6c, 0x6e, 0x1d, 0x4d, 0x5d, 0x70, 0x3c, 0x75, 0xc1,
-	0xf7, 0xa9, 0x0b, 0xde, 0x9e, 0xba, 0x85, 0xe3, 0x53, 0xb7, 0xf0, 0xf5, 0xd4, 0x2d, 0x3c, 0xbf,
-	0xf1, 0x37, 0xd7, 0x67, 0x76, 0x60, 0x50, 0x36, 0xff, 0xa7, 0x9b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x8f, 0x9f, 0xc3, 0x47, 0x40, 0x05, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xe6, 0x7f, 0x27, 0xfd, 0xc1, 0x8f, 0x31, 0xe8, 0xb4, 0x87, 0x4d, 0xd8, 0x83, 0xc4,
+	0x42, 0x93, 0xb4, 0x7a, 0x0a, 0x82, 0xd8, 0x68, 0x35, 0x82, 0x28, 0x5b, 0x8a, 0xd0, 0x4b, 0x98,
+	0x64, 0xa7, 0xc9, 0xd0, 0xdd, 0x99, 0x65, 0x67, 0xb6, 0x34, 0x37, 0xf1, 0x24, 0x9e, 0x3c, 0x7a,
+	0xec, 0x55, 0x4f, 0x1e, 0x0a, 0x7e, 0x85, 0xe2, 0xa9, 0x78, 0xf2, 0x54, 0x25, 0x3d, 0xe8, 0xc7,
+	0x90, 0x99, 0xd9, 0x4d, 0x5b, 0x68, 0xd5, 0x83, 0x82, 0xa7, 0x7d, 0xdf, 0xf7, 0x79, 0xe7, 0x7d,
+	0x9e, 0x99, 0x79, 0x66, 0x81, 0x3d, 0xe4, 0x22, 0xe0, 0xa2, 0x35, 0xc0, 0x6c, 0xa7, 0xb5, 0xbb,
+	0x32, 0x20, 0x12, 0xaf, 0xe8, 0xa4, 0x19, 0x46, 0x5c, 0x72, 0x78, 0xc5, 0xe0, 0x4d, 0x5d, 0x4a,
+	0xf0, 0xc5, 0xea, 0x88, 0x8f, 0xb8, 0xc6, 0x5b, 0x2a, 0x32, 0xad, 0x8b, 0x0b, 0xa6, 0xb5, 0x6f,
+	0x80, 0x64, 0x9d, 0x81, 0x4e, 0x59, 0x04, 0x99, 0xb1, 0x0c, 0x39, 0x65, 0x09, 0x7e, 0x2d, 0xc1,
+	0x03, 0x31, 0x6a, 0xed, 0xae, 0xa8, 0x8f, 0x01, 0x9c, 0xe7, 0x59, 0x50, 0x7c, 0x8a, 0x23, 0x1c,
+	0x08, 0xd8, 0x05, 0xf3, 0x82, 0x30, 0xaf, 0x4f, 0x18, 0x1e, 0xf8, 0xc4, 0x43, 0x56, 0x3d, 0xd7,
+	0xa8, 0xac, 0xd6, 0x9b, 0x17, 0x08, 0x6c, 0x6e, 0x10, 0xe6, 0xdd, 0x37, 0x7d, 0x6e, 0x45, 0x9c,
+	0x26, 0xb0, 0x0d, 0xaa, 0x1e, 0xd9, 0xc6, 0xb1, 0x2f, 0xfb, 0xe7, 0x86, 0x65, 0xeb, 0x56, 0xa3,
+	0xec, 0xc2, 0x04, 0x3b, 0xb3, 0x1c, 0x4e, 0x00, 0xf4, 0x08, 0xe3, 0x41, 0x7f, 0x18, 0x11, 0x2c,
+	0x29, 0x67, 0xfd, 0x6d, 0x42, 0x50, 0x4e, 0x93, 0x2f, 0x9c, 0x92, 0x0b, 0x32, 0x23, 0xef, 0x72,
+	0xca, 0xd6, 0xda, 0x87, 0xc7, 0xb5, 0xcc, 0xbb, 0x2f, 0xb5, 0xc6, 0x88, 0xca, 0x71, 0x3c, 0x68,
+	0x0e, 0x79, 0x90, 0x1c, 0x49, 0xf2, 0x59, 0x16, 0xde, 0x4e, 0x4b, 0x4e, 0x42, 0x22, 0xf4, 0x02,
+	0xe1, 0xfe, 0xaf, 0x69, 0xba, 0x09, 0xcb, 0x3a, 0x21, 0x9d, 0xfc, 0x9b, 0xfd, 0x5a, 0xc6, 0x79,
+	0x00, 0x2a, 0x67, 0xf5, 0x54, 0x41, 0x41, 0x37, 0x22, 0xab, 0x6e, 0x35, 0xe6, 0x5c, 0x93, 0x40,
+	0x04, 0x4a, 0xe7, 0xb7, 0x92, 0xa6, 0x9d, 0xb2, 0x1a, 0xf2, 0x7d, 0xbf, 0x66, 0x39, 0x07, 0x16,
+	0x28, 0xf4, 0x58, 0x18, 0x4b, 0xb8, 0x0a, 0x4a, 0xd8, 0xf3, 0x22, 0x22, 0x84, 0x99, 0xb2, 0x86,
+	0x3e, 0x1d, 0x2c, 0x57, 0x93, 0xbd, 0xdc, 0x35, 0xc8, 0x86, 0x8c, 0x28, 0x1b, 0xb9, 0x69, 0x23,
+	0xc4, 0xa0, 0xa0, 0x2e, 0x4c, 0xa0, 0xec, 0x9f, 0xdf, 0xba, 0x99, 0xdc, 0xa9, 0xbe, 0x34, 0x52,
+	0x33, 0x2f, 0xbe, 0xbd, 0x5f, 0x4a, 0x89, 0x9d, 0xb7, 0x16, 0x28, 0x3e, 0x89, 0xe5, 0x3f, 0xac,
+	0xbb, 0x9c, 0xea, 0x76, 0x3e, 0x58, 0xa0, 0xb8, 0x11, 0x87, 0xa1, 0x3f, 0x51, 0xbc, 0x92, 0x4b,
+	0xec, 0x23, 0xeb, 0x2f, 0xf0, 0xea, 0xc9, 0x9d, 0x47, 0x09, 0xaf, 0xf5, 0xf1, 0x60, 0xf9, 0xf6,
+	0xd2, 0x4f, 0x57, 0xef, 0x99, 0x07, 0x1e, 0xd0, 0x51, 0xa4, 0xed, 0x25, 0x5a, 0xbb, 0xed, 0x5b,
+	0xed, 0xa6, 0xd1, 0xda, 0x43, 0x96, 0xf3, 0x0c, 0xcc, 0xdd, 0x53, 0x4e, 0xda, 0x64, 0x54, 0x5e,
+	0xe2, 0xb1, 0x45, 0x50, 0x26, 0x7b, 0x21, 0x67, 0x84, 0x49, 0x6d, 0xb2, 0xff, 0xdc, 0x59, 0xae,
+	0xfc, 0x87, 0x7d, 0x8a, 0x05, 0x11, 0xfa, 0x69, 0xcc, 0xb9, 0x69, 0xea, 0xbc, 0xca, 0x82, 0xf2,
+	0x63, 0x22, 0xb1, 0x87, 0x25, 0x86, 0x75, 0x50, 0xf1, 0x88, 0x18, 0x46, 0x34, 0x54, 0x22, 0x92,
+	0xf1, 0x67, 0x4b, 0xf0, 0x0e, 0xa8, 0x98, 0xe7, 0x16, 0x33, 0x2a, 0xd3, 0x4b, 0xb3, 0x2f, 0x7c,
+	0xe4, 0x33, 0xbd, 0x2e, 0xf0, 0xd2, 0x50, 0x40, 0x08, 0xf2, 0xea, 0x88, 0x51, 0x4e, 0xcf, 0xd6,
+	0xb1, 0x52, 0xe7, 0x51, 0x11, 0xfa, 0x78, 0x82, 0xf2, 0xba, 0x9c, 0xa6, 0xaa, 0x9b, 0xe1, 0x80,
+	0xa0, 0x82, 0xe9, 0x56, 0x31, 0xbc, 0x0a, 0x8a, 0x62, 0x12, 0x0c, 0xb8, 0x8f, 0x8a, 0xba, 0x9a,
+	0x64, 0x70, 0x01, 0xe4, 0xe2, 0x88, 0xa2, 0x92, 0x76, 0x5e, 0x69, 0x7a, 0x5c, 0xcb, 0x6d, 0xba,
+	0x3d, 0x57, 0xd5, 0xe0, 0x75, 0x50, 0x8e, 0x23, 0xda, 0x1f, 0x63, 0x31, 0x46, 0x65, 0x8d, 0x57,
+	0xa6, 0xc7, 0xb5, 0xd2, 0xa6, 0xdb, 0x7b, 0x88, 0xc5, 0xd8, 0x2d, 0xc5, 0x11, 0x55, 0x81, 0xb3,
+	0x05, 0xe6, 0xd7, 0xf1, 0x50, 0xf2, 0x68, 0xa2, 0xc5, 0x5f, 0x72, 0xd0, 0x4d, 0x50, 0xc0, 0x5e,
+	0x40, 0x19, 0xca, 0xfe, 0xc2, 0xe4, 0xa6, 0xad, 0x93, 0x57, 0x1e, 0x58, 0xeb, 0x1e, 0x4e, 0x6d,
+	0xeb, 0x68, 0x6a, 0x5b, 0x5f, 0xa7, 0xb6, 0xf5, 0xfa, 0xc4, 0xce, 0x1c, 0x9d, 0xd8, 0x99, 0xcf,
+	0x27, 0x76, 0x66, 0xeb, 0xc6, 0xef, 0x58, 0x43, 0xfb, 0x6b, 0x50, 0xd4, 0xbf, 0xdd, 0x9b, 0x3f,
+	0x06, 0x00, 0x09, 0xd7, 0x19, 0x83, 0x17, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -554,6 +628,33 @@ func (this *Supply) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FactoryDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FactoryDenom)
+	if !ok {
+		that2, ok := that.(FactoryDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -574,6 +675,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -896,6 +1011,43 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -922,6 +1074,12 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1059,6 +1217,23 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1148,6 +1323,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2028,6 +2237,120 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
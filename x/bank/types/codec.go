@@ -14,12 +14,22 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom", nil)
+	cdc.RegisterConcrete(&MsgMint{}, "cosmos-sdk/MsgMint", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "cosmos-sdk/MsgBurn", nil)
+	cdc.RegisterConcrete(&MsgChangeAdmin{}, "cosmos-sdk/MsgChangeAdmin", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
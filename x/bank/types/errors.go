@@ -12,4 +12,7 @@ var (
 	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
 	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 6, "client denom metadata not found")
 	ErrInvalidKey            = sdkerrors.Register(ModuleName, 7, "invalid key")
+	ErrInvalidFactoryDenom   = sdkerrors.Register(ModuleName, 8, "invalid factory denom")
+	ErrFactoryDenomExists    = sdkerrors.Register(ModuleName, 9, "factory denom already exists")
+	ErrFactoryDenomNotFound  = sdkerrors.Register(ModuleName, 10, "factory denom not found")
 )
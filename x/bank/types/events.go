@@ -19,6 +19,15 @@ const (
 	EventTypeCoinMint     = "coinbase" // NOTE(fdymylja): using mint clashes with mint module event
 	EventTypeCoinBurn     = "burn"
 
+	// token factory events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeChangeDenomAdmin = "change_denom_admin"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyNewAdmin = "new_admin"
+
 	AttributeKeySpender  = "spender"
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
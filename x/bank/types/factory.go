@@ -0,0 +1,107 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of all the denoms created by the token
+	// factory.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory
+	// denom.
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the bech32 creator address of
+	// a factory denom.
+	MaxCreatorLength = 59 + 16
+)
+
+// GetFactoryDenom returns the factory denom created by creator with the given
+// subdenom, of the form factory/{creator}/{subdenom}. An error is returned if
+// the result is not a valid denom.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", ErrInvalidFactoryDenom.Wrap("subdenom cannot be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", ErrInvalidFactoryDenom.Wrapf("subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", ErrInvalidFactoryDenom.Wrapf("creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", ErrInvalidFactoryDenom.Wrapf("creator %s contains a slash", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", ErrInvalidFactoryDenom.Wrap(err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructFactoryDenom returns the creator and the subdenom of a factory
+// denom. The subdenom may itself contain slashes.
+func DeconstructFactoryDenom(denom string) (creator sdk.AccAddress, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", ErrInvalidFactoryDenom.Wrap(err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != FactoryDenomPrefix {
+		return nil, "", ErrInvalidFactoryDenom.Wrapf("denom %s is not of the form %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	creator, err = sdk.AccAddressFromBech32(parts[1])
+	if err != nil {
+		return nil, "", ErrInvalidFactoryDenom.Wrapf("invalid creator address: %s", err)
+	}
+
+	return creator, parts[2], nil
+}
+
+// NewFactoryDenom creates a new FactoryDenom instance.
+//nolint:interfacer
+func NewFactoryDenom(denom string, admin sdk.AccAddress) FactoryDenom {
+	fd := FactoryDenom{Denom: denom}
+	if !admin.Empty() {
+		fd.Admin = admin.String()
+	}
+
+	return fd
+}
+
+// Validate performs a basic validation of the factory denom and its admin.
+func (fd FactoryDenom) Validate() error {
+	if _, _, err := DeconstructFactoryDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	if fd.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(fd.Admin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// AdminAddress returns the admin of the factory denom, or nil if the denom has
+// no admin.
+func (fd FactoryDenom) AdminAddress() sdk.AccAddress {
+	if fd.Admin == "" {
+		return nil
+	}
+
+	admin, err := sdk.AccAddressFromBech32(fd.Admin)
+	if err != nil {
+		panic(err)
+	}
+
+	return admin
+}
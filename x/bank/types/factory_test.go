@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGetFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________")
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   bool
+	}{
+		{"valid", creator.String(), "utoken", "factory/" + creator.String() + "/utoken", false},
+		{"subdenom with slashes", creator.String(), "u/token", "factory/" + creator.String() + "/u/token", false},
+		{"empty subdenom", creator.String(), "", "", true},
+		{"invalid subdenom", creator.String(), "u token", "", true},
+		{"subdenom too long", creator.String(), strings.Repeat("a", types.MaxSubdenomLength+1), "", true},
+		{"creator too long", strings.Repeat("a", types.MaxCreatorLength+1), "utoken", "", true},
+		{"creator with slash", "cosmos/1", "utoken", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetFactoryDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidFactoryDenom)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+
+			gotCreator, gotSubdenom, err := types.DeconstructFactoryDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+}
+
+func TestDeconstructFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________")
+
+	for _, denom := range []string{
+		"utoken",
+		"ibc/" + creator.String() + "/utoken",
+		"factory/" + creator.String(),
+		"factory/invalid/utoken",
+	} {
+		_, _, err := types.DeconstructFactoryDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, denom)
+	}
+}
+
+func TestFactoryDenomValidate(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	denom := "factory/" + admin.String() + "/utoken"
+
+	require.NoError(t, types.NewFactoryDenom(denom, admin).Validate())
+	require.NoError(t, types.NewFactoryDenom(denom, nil).Validate())
+	require.Error(t, types.NewFactoryDenom("utoken", admin).Validate())
+	require.Error(t, types.FactoryDenom{Denom: denom, Admin: "invalid"}.Validate())
+
+	require.Equal(t, admin, types.NewFactoryDenom(denom, admin).AdminAddress())
+	require.Nil(t, types.NewFactoryDenom(denom, nil).AdminAddress())
+}
+
+func TestFactoryMsgsValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________")
+	denom := "factory/" + sender.String() + "/utoken"
+	metadata := types.Metadata{
+		DenomUnits: []*types.DenomUnit{{Denom: denom}},
+		Base:       denom,
+		Display:    denom,
+		Name:       "Token",
+		Symbol:     "TKN",
+	}
+	invalidMetadata := metadata
+	invalidMetadata.Name = ""
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"create denom", types.NewMsgCreateDenom(sender, "utoken"), false},
+		{"create denom invalid subdenom", types.NewMsgCreateDenom(sender, "u token"), true},
+		{"create denom empty sender", types.NewMsgCreateDenom(nil, "utoken"), true},
+		{"mint", types.NewMsgMint(sender, sdk.NewInt64Coin(denom, 10)), false},
+		{"mint zero", types.NewMsgMint(sender, sdk.NewInt64Coin(denom, 0)), true},
+		{"mint not a factory denom", types.NewMsgMint(sender, sdk.NewInt64Coin("stake", 10)), true},
+		{"burn", types.NewMsgBurn(sender, sdk.NewInt64Coin(denom, 10)), false},
+		{"burn zero", types.NewMsgBurn(sender, sdk.NewInt64Coin(denom, 0)), true},
+		{"change admin", types.NewMsgChangeAdmin(sender, denom, sdk.AccAddress("admin_______________")), false},
+		{"renounce admin", types.NewMsgChangeAdmin(sender, denom, nil), false},
+		{"change admin not a factory denom", types.NewMsgChangeAdmin(sender, "stake", nil), true},
+		{"change admin invalid new admin", &types.MsgChangeAdmin{Sender: sender.String(), Denom: denom, NewAdmin: "invalid"}, true},
+		{"set denom metadata", types.NewMsgSetDenomMetadata(sender, metadata), false},
+		{"set denom metadata invalid metadata", types.NewMsgSetDenomMetadata(sender, invalidMetadata), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{sender}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
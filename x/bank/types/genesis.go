@@ -46,6 +46,19 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenFactoryDenoms := make(map[string]bool)
+	for _, fd := range gs.FactoryDenoms {
+		if seenFactoryDenoms[fd.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", fd.Denom)
+		}
+
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[fd.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -25,6 +25,7 @@ var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
+	FactoryAdminPrefix  = []byte{0x04}
 
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
@@ -67,3 +68,9 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateFactoryAdminPrefix creates the prefix of the admin store entries of
+// the factory denoms created by the given creator.
+func CreateFactoryAdminPrefix(creator string) []byte {
+	return append(FactoryAdminPrefix, FactoryDenomPrefix+"/"+creator+"/"...)
+}
@@ -7,8 +7,13 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend             = "send"
+	TypeMsgMultiSend        = "multisend"
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "mint"
+	TypeMsgBurn             = "burn"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -101,6 +106,203 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgCreateDenom{}
+
+// NewMsgCreateDenom - construct a msg to create a token factory denom.
+//nolint:interfacer
+func NewMsgCreateDenom(sender sdk.AccAddress, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender.String(), Subdenom: subdenom}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	_, err := GetFactoryDenom(msg.Sender, msg.Subdenom)
+	return err
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMint{}
+
+// NewMsgMint - construct a msg to mint token factory coins to the sender.
+//nolint:interfacer
+func NewMsgMint(sender sdk.AccAddress, amount sdk.Coin) *MsgMint {
+	return &MsgMint{Sender: sender.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMint) ValidateBasic() error {
+	return validateFactoryCoin(msg.Sender, msg.Amount)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgBurn{}
+
+// NewMsgBurn - construct a msg to burn token factory coins of the sender.
+//nolint:interfacer
+func NewMsgBurn(sender sdk.AccAddress, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{Sender: sender.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	return validateFactoryCoin(msg.Sender, msg.Amount)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgChangeAdmin{}
+
+// NewMsgChangeAdmin - construct a msg to change the admin of a token factory
+// denom. An empty newAdmin renounces the administration of the denom.
+//nolint:interfacer
+func NewMsgChangeAdmin(sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) *MsgChangeAdmin {
+	msg := &MsgChangeAdmin{Sender: sender.String(), Denom: denom}
+	if !newAdmin.Empty() {
+		msg.NewAdmin = newAdmin.String()
+	}
+
+	return msg
+}
+
+// Route Implements Msg.
+func (msg MsgChangeAdmin) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgChangeAdmin) Type() string { return TypeMsgChangeAdmin }
+
+// ValidateBasic Implements Msg.
+func (msg MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	_, _, err := DeconstructFactoryDenom(msg.Denom)
+	return err
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomMetadata{}
+
+// NewMsgSetDenomMetadata - construct a msg to update the metadata of a token
+// factory denom.
+//nolint:interfacer
+func NewMsgSetDenomMetadata(sender sdk.AccAddress, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Sender: sender.String(), Metadata: metadata}
+}
+
+// Route Implements Msg.
+func (msg MsgSetDenomMetadata) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetDenomMetadata) Type() string { return TypeMsgSetDenomMetadata }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	_, _, err := DeconstructFactoryDenom(msg.Metadata.Base)
+	return err
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// validateFactoryCoin validates the sender and the positive factory coin of a
+// mint or burn msg.
+func validateFactoryCoin(sender string, amount sdk.Coin) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	_, _, err := DeconstructFactoryDenom(amount.Denom)
+	return err
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
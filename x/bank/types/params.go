@@ -20,6 +20,11 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeyDenomCreationFee is store's key for the DenomCreationFee option
+	KeyDenomCreationFee = []byte("DenomCreationFee")
+
+	// DefaultDenomCreationFee is the default fee to create a factory denom
+	DefaultDenomCreationFee = sdk.NewCoins()
 )
 
 // ParamKeyTable for bank module.
@@ -32,6 +37,7 @@ func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams) Par
 	return Params{
 		SendEnabled:        sendEnabledParams,
 		DefaultSendEnabled: defaultSendEnabled,
+		DenomCreationFee:   DefaultDenomCreationFee,
 	}
 }
 
@@ -41,6 +47,7 @@ func DefaultParams() Params {
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
 		DefaultSendEnabled: true,
+		DenomCreationFee:   DefaultDenomCreationFee,
 	}
 }
 
@@ -49,6 +56,9 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
@@ -78,7 +88,9 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	params := NewParams(p.DefaultSendEnabled, sendParams)
+	params.DenomCreationFee = p.DenomCreationFee
+	return params
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -86,6 +98,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeyDenomCreationFee, &p.DenomCreationFee, validateDenomCreationFee),
 	}
 }
 
@@ -150,3 +163,14 @@ func validateIsBool(i interface{}) error {
 	}
 	return nil
 }
+
+func validateDenomCreationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+	return nil
+}
@@ -854,6 +854,218 @@ func (m *QueryDenomOwnersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryFactoryDenomRequest is the request type for the Query/FactoryDenom RPC
+// method.
+//
+// Since: cosmos-sdk 0.46
+type QueryFactoryDenomRequest struct {
+	// denom is the token factory denom to query.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFactoryDenomRequest) Reset()         { *m = QueryFactoryDenomRequest{} }
+func (m *QueryFactoryDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomRequest) ProtoMessage()    {}
+func (*QueryFactoryDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{17}
+}
+func (m *QueryFactoryDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomRequest.Merge(m, src)
+}
+func (m *QueryFactoryDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomRequest proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFactoryDenomResponse is the response type for the Query/FactoryDenom
+// RPC method.
+//
+// Since: cosmos-sdk 0.46
+type QueryFactoryDenomResponse struct {
+	FactoryDenom FactoryDenom `protobuf:"bytes,1,opt,name=factory_denom,json=factoryDenom,proto3" json:"factory_denom"`
+}
+
+func (m *QueryFactoryDenomResponse) Reset()         { *m = QueryFactoryDenomResponse{} }
+func (m *QueryFactoryDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomResponse) ProtoMessage()    {}
+func (*QueryFactoryDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{18}
+}
+func (m *QueryFactoryDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomResponse.Merge(m, src)
+}
+func (m *QueryFactoryDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomResponse proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomResponse) GetFactoryDenom() FactoryDenom {
+	if m != nil {
+		return m.FactoryDenom
+	}
+	return FactoryDenom{}
+}
+
+// QueryFactoryDenomsFromCreatorRequest is the request type for the
+// Query/FactoryDenomsFromCreator RPC method.
+//
+// Since: cosmos-sdk 0.46
+type QueryFactoryDenomsFromCreatorRequest struct {
+	// creator is the address of the account which created the denoms.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFactoryDenomsFromCreatorRequest) Reset()         { *m = QueryFactoryDenomsFromCreatorRequest{} }
+func (m *QueryFactoryDenomsFromCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomsFromCreatorRequest) ProtoMessage()    {}
+func (*QueryFactoryDenomsFromCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{19}
+}
+func (m *QueryFactoryDenomsFromCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomsFromCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomsFromCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomsFromCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomsFromCreatorRequest.Merge(m, src)
+}
+func (m *QueryFactoryDenomsFromCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomsFromCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomsFromCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomsFromCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomsFromCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryFactoryDenomsFromCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFactoryDenomsFromCreatorResponse is the response type for the
+// Query/FactoryDenomsFromCreator RPC method.
+//
+// Since: cosmos-sdk 0.46
+type QueryFactoryDenomsFromCreatorResponse struct {
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,1,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFactoryDenomsFromCreatorResponse) Reset()         { *m = QueryFactoryDenomsFromCreatorResponse{} }
+func (m *QueryFactoryDenomsFromCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFactoryDenomsFromCreatorResponse) ProtoMessage()    {}
+func (*QueryFactoryDenomsFromCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{20}
+}
+func (m *QueryFactoryDenomsFromCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFactoryDenomsFromCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFactoryDenomsFromCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFactoryDenomsFromCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFactoryDenomsFromCreatorResponse.Merge(m, src)
+}
+func (m *QueryFactoryDenomsFromCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFactoryDenomsFromCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFactoryDenomsFromCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFactoryDenomsFromCreatorResponse proto.InternalMessageInfo
+
+func (m *QueryFactoryDenomsFromCreatorResponse) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *QueryFactoryDenomsFromCreatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -872,72 +1084,85 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersRequest)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersRequest")
 	proto.RegisterType((*DenomOwner)(nil), "cosmos.bank.v1beta1.DenomOwner")
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
+	proto.RegisterType((*QueryFactoryDenomRequest)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomRequest")
+	proto.RegisterType((*QueryFactoryDenomResponse)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomResponse")
+	proto.RegisterType((*QueryFactoryDenomsFromCreatorRequest)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomsFromCreatorRequest")
+	proto.RegisterType((*QueryFactoryDenomsFromCreatorResponse)(nil), "cosmos.bank.v1beta1.QueryFactoryDenomsFromCreatorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 953 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x14, 0x36, 0x6d, 0x5f, 0x80, 0xc3, 0x6c, 0x10, 0xad, 0xcb, 0x26, 0xc8, 0x0b, 0xdb,
-	0x76, 0xb7, 0xb5, 0xdb, 0x2c, 0x12, 0x2c, 0x17, 0xd4, 0x2c, 0x82, 0x03, 0x42, 0x5b, 0xbc, 0x9c,
-	0x90, 0x50, 0x35, 0x49, 0x8c, 0xb1, 0x9a, 0x78, 0xbc, 0x19, 0x87, 0x25, 0xaa, 0x56, 0x42, 0x9c,
-	0x38, 0x01, 0x12, 0x17, 0x24, 0x84, 0x58, 0x2e, 0x20, 0x38, 0xf3, 0x47, 0xf4, 0xc0, 0x61, 0x45,
-	0x2f, 0x9c, 0x00, 0xb5, 0x1c, 0xf8, 0x33, 0x50, 0x66, 0xde, 0x38, 0x76, 0xe2, 0x38, 0x16, 0x84,
-	0x53, 0xec, 0x99, 0xf7, 0xe3, 0xfb, 0xbe, 0x99, 0xf7, 0x9e, 0x03, 0xf5, 0x36, 0x17, 0x3d, 0x2e,
-	0xec, 0x16, 0x0b, 0x8e, 0xed, 0x0f, 0xf7, 0x5b, 0x6e, 0xc4, 0xf6, 0xed, 0x7b, 0x03, 0xb7, 0x3f,
-	0xb4, 0xc2, 0x3e, 0x8f, 0x38, 0xbd, 0xac, 0x0c, 0xac, 0x91, 0x81, 0x85, 0x06, 0xc6, 0xf5, 0xd8,
-	0x4b, 0xb8, 0xca, 0x3a, 0xf6, 0x0d, 0x99, 0xe7, 0x07, 0x2c, 0xf2, 0x79, 0xa0, 0x02, 0x18, 0x55,
-	0x8f, 0x7b, 0x5c, 0x3e, 0xda, 0xa3, 0x27, 0x5c, 0x7d, 0xd6, 0xe3, 0xdc, 0xeb, 0xba, 0x36, 0x0b,
-	0x7d, 0x9b, 0x05, 0x01, 0x8f, 0xa4, 0x8b, 0xc0, 0xdd, 0x5a, 0x32, 0xbe, 0x8e, 0xdc, 0xe6, 0x7e,
-	0x30, 0xb5, 0x9f, 0x40, 0x2d, 0x11, 0xaa, 0xfd, 0x75, 0xb5, 0x7f, 0xa4, 0xd2, 0x22, 0x03, 0xf9,
-	0x62, 0xfa, 0x70, 0xf9, 0xed, 0x11, 0xe0, 0x26, 0xeb, 0xb2, 0xa0, 0xed, 0x3a, 0xee, 0xbd, 0x81,
-	0x2b, 0x22, 0xda, 0x80, 0x65, 0xd6, 0xe9, 0xf4, 0x5d, 0x21, 0xd6, 0xc8, 0x73, 0x64, 0x6b, 0xb5,
-	0xb9, 0xf6, 0xeb, 0xcf, 0xbb, 0x55, 0xf4, 0x3c, 0x50, 0x3b, 0x77, 0xa3, 0xbe, 0x1f, 0x78, 0x8e,
-	0x36, 0xa4, 0x55, 0xb8, 0xd4, 0x71, 0x03, 0xde, 0x5b, 0x5b, 0x1a, 0x79, 0x38, 0xea, 0xe5, 0x95,
-	0x95, 0x4f, 0x1f, 0xd6, 0x4b, 0x7f, 0x3f, 0xac, 0x97, 0xcc, 0x37, 0xa1, 0x9a, 0x4e, 0x25, 0x42,
-	0x1e, 0x08, 0x97, 0xde, 0x84, 0xe5, 0x96, 0x5a, 0x92, 0xb9, 0x2a, 0x8d, 0x75, 0x2b, 0x16, 0x59,
-	0xb8, 0x5a, 0x64, 0xeb, 0x36, 0xf7, 0x03, 0x47, 0x5b, 0x9a, 0xdf, 0x12, 0x78, 0x46, 0x46, 0x3b,
-	0xe8, 0x76, 0x31, 0xa0, 0xf8, 0x2f, 0xe0, 0x5f, 0x07, 0x18, 0x1f, 0x95, 0x64, 0x50, 0x69, 0x5c,
-	0x4b, 0xe1, 0x50, 0xb7, 0x40, 0xa3, 0x39, 0x64, 0x9e, 0x16, 0xcb, 0x49, 0x78, 0x26, 0xe8, 0xfe,
-	0x42, 0x60, 0x6d, 0x1a, 0x21, 0x72, 0xf6, 0x60, 0x05, 0x99, 0x8c, 0x30, 0x3e, 0x96, 0x4b, 0xba,
-	0xb9, 0x77, 0xfa, 0x7b, 0xbd, 0xf4, 0xd3, 0x1f, 0xf5, 0x2d, 0xcf, 0x8f, 0x3e, 0x18, 0xb4, 0xac,
-	0x36, 0xef, 0xe1, 0x21, 0xe2, 0xcf, 0xae, 0xe8, 0x1c, 0xdb, 0xd1, 0x30, 0x74, 0x85, 0x74, 0x10,
-	0x4e, 0x1c, 0x9c, 0xbe, 0x91, 0xc1, 0x6b, 0x73, 0x2e, 0x2f, 0x85, 0x32, 0x49, 0xcc, 0x3c, 0x46,
-	0xbd, 0xdf, 0xe1, 0x11, 0xeb, 0xde, 0x1d, 0x84, 0x61, 0x77, 0xa8, 0xf5, 0x4e, 0x6b, 0x47, 0x16,
-	0xa0, 0xdd, 0xa9, 0xd6, 0x2e, 0x95, 0x0d, 0xb5, 0x6b, 0x43, 0x59, 0xc8, 0x95, 0xff, 0x43, 0x39,
-	0x0c, 0xbd, 0x38, 0xdd, 0x76, 0xf0, 0xd6, 0x2b, 0x12, 0x77, 0xde, 0xd7, 0xa2, 0xc5, 0xd5, 0x42,
-	0x12, 0xd5, 0x62, 0x1e, 0xc2, 0xd3, 0x13, 0xd6, 0x48, 0xfa, 0x25, 0x28, 0xb3, 0x1e, 0x1f, 0x04,
-	0xd1, 0xdc, 0x1a, 0x69, 0x3e, 0x3e, 0x22, 0xed, 0xa0, 0xb9, 0x59, 0x05, 0x2a, 0x23, 0x1e, 0xb2,
-	0x3e, 0xeb, 0xe9, 0x12, 0x31, 0x0f, 0xb1, 0xec, 0xf5, 0x2a, 0x66, 0xb9, 0x05, 0xe5, 0x50, 0xae,
-	0x60, 0x96, 0x0d, 0x2b, 0xa3, 0xdd, 0x59, 0xca, 0x49, 0xe7, 0x51, 0x0e, 0x66, 0x07, 0x0c, 0x19,
-	0xf1, 0xb5, 0x11, 0x0f, 0xf1, 0x96, 0x1b, 0xb1, 0x0e, 0x8b, 0xd8, 0x82, 0xaf, 0x88, 0xf9, 0x23,
-	0x81, 0x8d, 0xcc, 0x34, 0x48, 0xe0, 0x00, 0x56, 0x7b, 0xb8, 0xa6, 0x0b, 0xeb, 0x4a, 0x26, 0x07,
-	0xed, 0x89, 0x2c, 0xc6, 0x5e, 0x8b, 0x3b, 0xf9, 0x7d, 0x58, 0x1f, 0x43, 0x9d, 0x14, 0x24, 0xfb,
-	0xf8, 0xdf, 0x4b, 0x8a, 0x38, 0x45, 0xee, 0x55, 0x58, 0xd1, 0x30, 0x51, 0xc2, 0x42, 0xdc, 0x62,
-	0x27, 0xf3, 0x3e, 0xd6, 0xb0, 0x0c, 0x7f, 0xe7, 0x7e, 0xe0, 0xf6, 0x45, 0x2e, 0x9e, 0x45, 0x75,
-	0x45, 0xf3, 0x04, 0x60, 0x9c, 0xf3, 0x5f, 0xf5, 0xe7, 0x5b, 0xe3, 0x21, 0xb1, 0x54, 0xac, 0x00,
-	0xe2, 0x51, 0xf1, 0x83, 0x6e, 0x26, 0x29, 0xda, 0xa8, 0x69, 0x13, 0x9e, 0x90, 0x54, 0x8f, 0xb8,
-	0x5c, 0xc7, 0x3b, 0x53, 0xcf, 0xd4, 0x75, 0xec, 0xef, 0x54, 0x3a, 0xe3, 0x58, 0x0b, 0xbb, 0x31,
-	0x8d, 0xb3, 0x55, 0xb8, 0x24, 0x91, 0xd2, 0xaf, 0x08, 0x2c, 0xe3, 0xd0, 0xa0, 0x5b, 0x99, 0x60,
-	0x32, 0xa6, 0xb6, 0xb1, 0x5d, 0xc0, 0x52, 0xa5, 0x35, 0x5f, 0xfe, 0xe4, 0xec, 0xaf, 0x2f, 0x97,
-	0x1a, 0x74, 0xcf, 0xce, 0xfe, 0x76, 0x50, 0xe3, 0xc3, 0x3e, 0x41, 0xfd, 0x1f, 0xd8, 0xad, 0xe1,
-	0x91, 0xba, 0x13, 0x5f, 0x13, 0xa8, 0x24, 0x46, 0x1a, 0xdd, 0x99, 0x9d, 0x74, 0x7a, 0x36, 0x1b,
-	0xbb, 0x05, 0xad, 0x11, 0xa6, 0x2d, 0x61, 0x6e, 0xd3, 0xcd, 0x82, 0x30, 0xe9, 0xe7, 0x04, 0x2a,
-	0x89, 0xa1, 0x91, 0x87, 0x6e, 0x7a, 0x92, 0xe5, 0xa1, 0xcb, 0x98, 0x44, 0xe6, 0x55, 0x89, 0xee,
-	0x0a, 0xdd, 0xc8, 0x44, 0x87, 0x93, 0xe4, 0x33, 0x02, 0x2b, 0xba, 0x9d, 0xd3, 0x9c, 0x13, 0x9a,
-	0x18, 0x10, 0xc6, 0xf5, 0x22, 0xa6, 0x08, 0xe4, 0x86, 0x04, 0xf2, 0x02, 0xbd, 0x9a, 0x03, 0xc4,
-	0x3e, 0x91, 0xe7, 0xf7, 0x80, 0x7e, 0x4c, 0xa0, 0xac, 0x5a, 0x38, 0xdd, 0x9c, 0x9d, 0x23, 0x35,
-	0x2f, 0x8c, 0xad, 0xf9, 0x86, 0x85, 0x34, 0x51, 0xc3, 0x82, 0x7e, 0x4f, 0xe0, 0xc9, 0x54, 0x8f,
-	0xa3, 0xd6, 0xec, 0x04, 0x59, 0xfd, 0xd3, 0xb0, 0x0b, 0xdb, 0x23, 0xae, 0x17, 0x25, 0x2e, 0x8b,
-	0xee, 0x64, 0xe2, 0x92, 0xd2, 0x88, 0x23, 0xdd, 0x29, 0x63, 0xad, 0xbe, 0x23, 0xf0, 0x54, 0x7a,
-	0xd4, 0xd0, 0x79, 0x99, 0x27, 0x67, 0x9f, 0xb1, 0x57, 0xdc, 0x01, 0xb1, 0xee, 0x48, 0xac, 0xd7,
-	0xe8, 0xf3, 0x45, 0xb0, 0xd2, 0x6f, 0x08, 0x54, 0x12, 0xad, 0x2d, 0xef, 0xca, 0x4f, 0x37, 0xfe,
-	0xbc, 0x2b, 0x9f, 0xd1, 0x2f, 0xcd, 0x7d, 0x09, 0xed, 0x06, 0xdd, 0x9e, 0x0d, 0x0d, 0x5b, 0xa9,
-	0xd6, 0xb0, 0x79, 0xfb, 0xf4, 0xbc, 0x46, 0x1e, 0x9d, 0xd7, 0xc8, 0x9f, 0xe7, 0x35, 0xf2, 0xc5,
-	0x45, 0xad, 0xf4, 0xe8, 0xa2, 0x56, 0xfa, 0xed, 0xa2, 0x56, 0x7a, 0x77, 0x3b, 0xf7, 0xb3, 0xec,
-	0x23, 0x15, 0x5b, 0x7e, 0x9d, 0xb5, 0xca, 0xf2, 0xef, 0xca, 0xcd, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x40, 0x2b, 0xe5, 0x20, 0xa1, 0x0d, 0x00, 0x00,
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xbd, 0x6f, 0x23, 0x45,
+	0x14, 0xf7, 0x04, 0xce, 0xc9, 0x3d, 0x27, 0x57, 0x4c, 0x8c, 0x2e, 0xd9, 0x70, 0x36, 0xec, 0xe5,
+	0x2e, 0x1f, 0x17, 0xef, 0x26, 0x3e, 0x10, 0xe4, 0x1a, 0x14, 0x07, 0x85, 0x82, 0x8f, 0x0b, 0x3e,
+	0x2a, 0x24, 0x14, 0x8d, 0xed, 0x8d, 0xb1, 0x62, 0xef, 0xf8, 0x3c, 0x6b, 0x8e, 0x28, 0x8a, 0x84,
+	0xa8, 0xa8, 0x00, 0x89, 0x06, 0x84, 0x10, 0x07, 0x05, 0x08, 0x6a, 0x2a, 0x2a, 0xca, 0x14, 0x14,
+	0x27, 0x68, 0xa8, 0x00, 0x25, 0x14, 0xfc, 0x19, 0xc8, 0x33, 0x6f, 0xec, 0xdd, 0x78, 0x6c, 0xaf,
+	0x82, 0xaf, 0x8a, 0xfd, 0xe6, 0x7d, 0xfc, 0x7e, 0xbf, 0xf9, 0x78, 0xcf, 0x81, 0x6c, 0x99, 0x8b,
+	0x06, 0x17, 0x6e, 0x89, 0xf9, 0x07, 0xee, 0x7b, 0x1b, 0x25, 0x2f, 0x60, 0x1b, 0xee, 0xfd, 0xb6,
+	0xd7, 0x3a, 0x74, 0x9a, 0x2d, 0x1e, 0x70, 0x3a, 0xab, 0x1c, 0x9c, 0x8e, 0x83, 0x83, 0x0e, 0xd6,
+	0x6a, 0x37, 0x4a, 0x78, 0xca, 0xbb, 0x1b, 0xdb, 0x64, 0xd5, 0x9a, 0xcf, 0x82, 0x1a, 0xf7, 0x55,
+	0x02, 0x2b, 0x5d, 0xe5, 0x55, 0x2e, 0x3f, 0xba, 0x9d, 0x4f, 0x68, 0x7d, 0xba, 0xca, 0x79, 0xb5,
+	0xee, 0xb9, 0xac, 0x59, 0x73, 0x99, 0xef, 0xf3, 0x40, 0x86, 0x08, 0x5c, 0xcd, 0x84, 0xf3, 0xeb,
+	0xcc, 0x65, 0x5e, 0xf3, 0xfb, 0xd6, 0x43, 0xa8, 0x3b, 0x5f, 0x70, 0x7d, 0x5e, 0xad, 0xef, 0xa9,
+	0xb2, 0xea, 0x8b, 0x5a, 0xb2, 0x6b, 0x30, 0xfb, 0x66, 0x07, 0x70, 0x81, 0xd5, 0x99, 0x5f, 0xf6,
+	0x8a, 0xde, 0xfd, 0xb6, 0x27, 0x02, 0x9a, 0x87, 0x49, 0x56, 0xa9, 0xb4, 0x3c, 0x21, 0xe6, 0xc8,
+	0x33, 0x64, 0xf9, 0x72, 0x61, 0xee, 0xb7, 0x9f, 0x72, 0x69, 0x8c, 0xdc, 0x52, 0x2b, 0xf7, 0x82,
+	0x56, 0xcd, 0xaf, 0x16, 0xb5, 0x23, 0x4d, 0xc3, 0xa5, 0x8a, 0xe7, 0xf3, 0xc6, 0xdc, 0x44, 0x27,
+	0xa2, 0xa8, 0xbe, 0xdc, 0x99, 0xfa, 0xe8, 0x61, 0x36, 0xf1, 0xef, 0xc3, 0x6c, 0xc2, 0x7e, 0x15,
+	0xd2, 0xd1, 0x52, 0xa2, 0xc9, 0x7d, 0xe1, 0xd1, 0xdb, 0x30, 0x59, 0x52, 0x26, 0x59, 0x2b, 0x95,
+	0x9f, 0x77, 0xba, 0x22, 0x0b, 0x4f, 0x8b, 0xec, 0x6c, 0xf3, 0x9a, 0x5f, 0xd4, 0x9e, 0xf6, 0xd7,
+	0x04, 0xae, 0xca, 0x6c, 0x5b, 0xf5, 0x3a, 0x26, 0x14, 0xff, 0x07, 0xfc, 0x0e, 0x40, 0x6f, 0xab,
+	0x24, 0x83, 0x54, 0xfe, 0x66, 0x04, 0x87, 0x3a, 0x05, 0x1a, 0xcd, 0x2e, 0xab, 0x6a, 0xb1, 0x8a,
+	0xa1, 0xc8, 0x10, 0xdd, 0x5f, 0x09, 0xcc, 0xf5, 0x23, 0x44, 0xce, 0x55, 0x98, 0x42, 0x26, 0x1d,
+	0x8c, 0x4f, 0x0c, 0x25, 0x5d, 0x58, 0x3f, 0xf9, 0x33, 0x9b, 0xf8, 0xf1, 0xaf, 0xec, 0x72, 0xb5,
+	0x16, 0xbc, 0xdb, 0x2e, 0x39, 0x65, 0xde, 0xc0, 0x4d, 0xc4, 0x3f, 0x39, 0x51, 0x39, 0x70, 0x83,
+	0xc3, 0xa6, 0x27, 0x64, 0x80, 0x28, 0x76, 0x93, 0xd3, 0x57, 0x0c, 0xbc, 0x96, 0x46, 0xf2, 0x52,
+	0x28, 0xc3, 0xc4, 0xec, 0x03, 0xd4, 0xfb, 0x2d, 0x1e, 0xb0, 0xfa, 0xbd, 0x76, 0xb3, 0x59, 0x3f,
+	0xd4, 0x7a, 0x47, 0xb5, 0x23, 0x63, 0xd0, 0xee, 0x44, 0x6b, 0x17, 0xa9, 0x86, 0xda, 0x95, 0x21,
+	0x29, 0xa4, 0xe5, 0x71, 0x28, 0x87, 0xa9, 0xc7, 0xa7, 0xdb, 0x1a, 0x9e, 0x7a, 0x45, 0xe2, 0xee,
+	0xbe, 0x16, 0xad, 0x7b, 0x5b, 0x48, 0xe8, 0xb6, 0xd8, 0xbb, 0xf0, 0xd4, 0x39, 0x6f, 0x24, 0xfd,
+	0x02, 0x24, 0x59, 0x83, 0xb7, 0xfd, 0x60, 0xe4, 0x1d, 0x29, 0x3c, 0xd9, 0x21, 0x5d, 0x44, 0x77,
+	0x3b, 0x0d, 0x54, 0x66, 0xdc, 0x65, 0x2d, 0xd6, 0xd0, 0x57, 0xc4, 0xde, 0x85, 0xd9, 0x88, 0x15,
+	0xab, 0x6c, 0x42, 0xb2, 0x29, 0x2d, 0x58, 0x65, 0xc1, 0x31, 0x3c, 0x77, 0x8e, 0x0a, 0xd2, 0x75,
+	0x54, 0x80, 0x5d, 0x01, 0x4b, 0x66, 0x7c, 0xb9, 0xc3, 0x43, 0xbc, 0xee, 0x05, 0xac, 0xc2, 0x02,
+	0x36, 0xe6, 0x23, 0x62, 0xff, 0x40, 0x60, 0xc1, 0x58, 0x06, 0x09, 0x6c, 0xc1, 0xe5, 0x06, 0xda,
+	0xf4, 0xc5, 0xba, 0x66, 0xe4, 0xa0, 0x23, 0x91, 0x45, 0x2f, 0x6a, 0x7c, 0x3b, 0xbf, 0x01, 0xf3,
+	0x3d, 0xa8, 0xe7, 0x05, 0x31, 0x6f, 0xff, 0x3b, 0x60, 0x99, 0x42, 0x90, 0xdc, 0x4b, 0x30, 0xa5,
+	0x61, 0xa2, 0x84, 0xb1, 0xb8, 0x75, 0x83, 0xec, 0x07, 0x70, 0xb5, 0x97, 0xfe, 0xee, 0x03, 0xdf,
+	0x6b, 0x89, 0xa1, 0x78, 0xc6, 0xf5, 0x2a, 0xda, 0x47, 0x00, 0xbd, 0x9a, 0x17, 0x7a, 0x9f, 0x37,
+	0x7b, 0x4d, 0x62, 0x22, 0xde, 0x05, 0xe8, 0xb6, 0x8a, 0xef, 0xf5, 0x63, 0x12, 0xa1, 0x8d, 0x9a,
+	0x16, 0x60, 0x5a, 0x52, 0xdd, 0xe3, 0xd2, 0x8e, 0x67, 0x26, 0x6b, 0xd4, 0xb5, 0x17, 0x5f, 0x4c,
+	0x55, 0x7a, 0xb9, 0xc6, 0x77, 0x62, 0xd6, 0x11, 0xe8, 0x0e, 0x2b, 0x07, 0x1c, 0xf1, 0x0e, 0x3f,
+	0x30, 0x35, 0x98, 0x37, 0x44, 0x20, 0xb7, 0xd7, 0x60, 0x66, 0x5f, 0xd9, 0xf7, 0x7a, 0xa1, 0xa9,
+	0xfc, 0xb3, 0x46, 0x72, 0xe1, 0x0c, 0xa8, 0xe0, 0xf4, 0x7e, 0xc8, 0x66, 0x7f, 0x4b, 0x60, 0xb1,
+	0xaf, 0x96, 0xd8, 0x69, 0xf1, 0xc6, 0x76, 0xcb, 0x63, 0x01, 0x6f, 0x85, 0xda, 0x6f, 0x59, 0x59,
+	0x46, 0x6f, 0x2f, 0x3a, 0x8e, 0xed, 0xa0, 0xfd, 0x42, 0xe0, 0xc6, 0x08, 0x90, 0x28, 0xce, 0x1b,
+	0x70, 0x25, 0x22, 0x8e, 0xde, 0xfa, 0xd8, 0xea, 0xcc, 0x84, 0xd5, 0x19, 0xdf, 0x21, 0xc8, 0xff,
+	0x3c, 0x0d, 0x97, 0x24, 0x05, 0xfa, 0x39, 0x81, 0x49, 0x9c, 0x1c, 0xe8, 0xb2, 0x11, 0x96, 0x61,
+	0x74, 0xb3, 0x56, 0x62, 0x78, 0xaa, 0xb2, 0xf6, 0x8b, 0x1f, 0xfe, 0xfe, 0xcf, 0x67, 0x13, 0x79,
+	0xba, 0xee, 0x9a, 0x07, 0x48, 0xe9, 0x2d, 0xdc, 0x23, 0xbc, 0x84, 0xc7, 0x6e, 0x09, 0xc5, 0xa2,
+	0x5f, 0x12, 0x48, 0x85, 0xe6, 0x1a, 0xba, 0x36, 0xb8, 0x68, 0xff, 0x80, 0x66, 0xe5, 0x62, 0x7a,
+	0x23, 0x4c, 0x57, 0xc2, 0x5c, 0xa1, 0x4b, 0x31, 0x61, 0xd2, 0x4f, 0x08, 0xa4, 0x42, 0x93, 0xc3,
+	0x30, 0x74, 0xfd, 0xe3, 0x8c, 0x95, 0x8b, 0xe9, 0x8d, 0xe8, 0xae, 0x4b, 0x74, 0xd7, 0xe8, 0x82,
+	0x11, 0x1d, 0x8e, 0x13, 0x1f, 0x13, 0x98, 0xd2, 0x3d, 0x9d, 0x0e, 0xd9, 0xa1, 0x73, 0x53, 0x82,
+	0xb5, 0x1a, 0xc7, 0x15, 0x81, 0xdc, 0x92, 0x40, 0x6e, 0xd0, 0xeb, 0x43, 0x80, 0xb8, 0x47, 0x72,
+	0xff, 0x8e, 0xe9, 0x07, 0x04, 0x92, 0xaa, 0x8f, 0xd3, 0xa5, 0xc1, 0x35, 0x22, 0x43, 0x83, 0xb5,
+	0x3c, 0xda, 0x31, 0x96, 0x26, 0x6a, 0x62, 0xa0, 0xdf, 0x11, 0x98, 0x89, 0x34, 0x3a, 0xea, 0x0c,
+	0x2e, 0x60, 0x6a, 0xa2, 0x96, 0x1b, 0xdb, 0x1f, 0x71, 0x3d, 0x27, 0x71, 0x39, 0x74, 0xcd, 0x88,
+	0x4b, 0xbd, 0x03, 0x7b, 0xba, 0x5d, 0x76, 0xb5, 0xfa, 0x86, 0xc0, 0x95, 0xe8, 0xbc, 0x41, 0x47,
+	0x55, 0x3e, 0x3f, 0x00, 0x59, 0xeb, 0xf1, 0x03, 0x10, 0xeb, 0x9a, 0xc4, 0x7a, 0x93, 0x2e, 0xc6,
+	0xc1, 0x4a, 0xbf, 0x22, 0x90, 0x0a, 0xf5, 0xb7, 0x61, 0x47, 0xbe, 0xbf, 0xfb, 0x5b, 0xb9, 0x98,
+	0xde, 0x08, 0x6d, 0x43, 0x42, 0xbb, 0x45, 0x57, 0x06, 0x43, 0xc3, 0x7e, 0xda, 0xd5, 0xf0, 0x0b,
+	0x02, 0xd3, 0xe1, 0x47, 0x94, 0x0e, 0x29, 0x69, 0x68, 0x7f, 0x96, 0x13, 0xd7, 0x1d, 0x21, 0xae,
+	0x4a, 0x88, 0x8b, 0xd4, 0x36, 0x42, 0x8c, 0xbc, 0xfc, 0xb4, 0xf3, 0x6b, 0x63, 0x50, 0xbf, 0xa0,
+	0x9b, 0xf1, 0x0a, 0x1b, 0x1a, 0xa1, 0x75, 0xe7, 0x22, 0xa1, 0x88, 0xff, 0x79, 0x89, 0xdf, 0xa5,
+	0xb9, 0xd1, 0xf8, 0x85, 0x7b, 0x84, 0x6d, 0xf4, 0xb8, 0xb0, 0x7d, 0x72, 0x9a, 0x21, 0x8f, 0x4e,
+	0x33, 0xe4, 0xef, 0xd3, 0x0c, 0xf9, 0xf4, 0x2c, 0x93, 0x78, 0x74, 0x96, 0x49, 0xfc, 0x71, 0x96,
+	0x49, 0xbc, 0xbd, 0x32, 0xf4, 0x27, 0xd0, 0xfb, 0x2a, 0xbf, 0xfc, 0x25, 0x54, 0x4a, 0xca, 0x7f,
+	0x0d, 0xdc, 0xfe, 0x6f, 0x00, 0x4b, 0x0f, 0x35, 0x44, 0x0d, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -970,6 +1195,16 @@ type QueryClient interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error)
+	// FactoryDenom queries the admin of a token factory denom. As factory denoms
+	// contain slashes, the denom is passed as a query parameter.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsFromCreator queries all the token factory denoms created by
+	// an account.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenomsFromCreator(ctx context.Context, in *QueryFactoryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsFromCreatorResponse, error)
 }
 
 type queryClient struct {
@@ -1052,6 +1287,24 @@ func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersReque
 	return out, nil
 }
 
+func (c *queryClient) FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error) {
+	out := new(QueryFactoryDenomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/FactoryDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenomsFromCreator(ctx context.Context, in *QueryFactoryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsFromCreatorResponse, error) {
+	out := new(QueryFactoryDenomsFromCreatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/FactoryDenomsFromCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1072,6 +1325,16 @@ type QueryServer interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error)
+	// FactoryDenom queries the admin of a token factory denom. As factory denoms
+	// contain slashes, the denom is passed as a query parameter.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsFromCreator queries all the token factory denoms created by
+	// an account.
+	//
+	// Since: cosmos-sdk 0.46
+	FactoryDenomsFromCreator(context.Context, *QueryFactoryDenomsFromCreatorRequest) (*QueryFactoryDenomsFromCreatorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1102,6 +1365,12 @@ func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryD
 func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
+func (*UnimplementedQueryServer) FactoryDenom(ctx context.Context, req *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenom not implemented")
+}
+func (*UnimplementedQueryServer) FactoryDenomsFromCreator(ctx context.Context, req *QueryFactoryDenomsFromCreatorRequest) (*QueryFactoryDenomsFromCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenomsFromCreator not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1251,6 +1520,42 @@ func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FactoryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/FactoryDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenom(ctx, req.(*QueryFactoryDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomsFromCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenomsFromCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/FactoryDenomsFromCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenomsFromCreator(ctx, req.(*QueryFactoryDenomsFromCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1287,6 +1592,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
 		},
+		{
+			MethodName: "FactoryDenom",
+			Handler:    _Query_FactoryDenom_Handler,
+		},
+		{
+			MethodName: "FactoryDenomsFromCreator",
+			Handler:    _Query_FactoryDenomsFromCreator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -1936,72 +2249,226 @@ func (m *QueryDenomOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFactoryDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryFactoryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFactoryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Balance != nil {
-		l = m.Balance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFactoryDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFactoryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFactoryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
+	{
+		size, err := m.FactoryDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFactoryDenomsFromCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFactoryDenomsFromCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFactoryDenomsFromCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFactoryDenomsFromCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFactoryDenomsFromCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFactoryDenomsFromCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2196,6 +2663,66 @@ func (m *QueryDenomOwnersResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFactoryDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFactoryDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FactoryDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFactoryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFactoryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3868,6 +4395,409 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFactoryDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFactoryDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFactoryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFactoryDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFactoryDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFactoryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FactoryDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFactoryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFactoryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFactoryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFactoryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFactoryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFactoryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
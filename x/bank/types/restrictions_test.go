@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// recordingRestriction returns a SendRestrictionFn that records its name in
+// calls and redirects to redirect when it is set.
+func recordingRestriction(calls *[]string, name string, redirect sdk.AccAddress, err error) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		*calls = append(*calls, name)
+		if redirect != nil {
+			toAddr = redirect
+		}
+		return toAddr, err
+	}
+}
+
+func TestNoOpSendRestrictionFn(t *testing.T) {
+	fromAddr := sdk.AccAddress("from________________")
+	toAddr := sdk.AccAddress("to__________________")
+
+	newToAddr, err := types.NoOpSendRestrictionFn(sdk.Context{}, fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 1)))
+	require.NoError(t, err)
+	require.Equal(t, toAddr, newToAddr)
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	fromAddr := sdk.AccAddress("from________________")
+	toAddr := sdk.AccAddress("to__________________")
+	otherAddr := sdk.AccAddress("other_______________")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+	errRejected := errors.New("rejected")
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	var calls []string
+	first := recordingRestriction(&calls, "first", nil, nil)
+	require.NotNil(t, types.ComposeSendRestrictions(nil, first, nil))
+
+	testCases := []struct {
+		name       string
+		restrictor types.SendRestrictionFn
+		expCalls   []string
+		expAddr    sdk.AccAddress
+		expErr     error
+	}{
+		{
+			"runs in order",
+			types.ComposeSendRestrictions(first, recordingRestriction(&calls, "second", nil, nil)),
+			[]string{"first", "second"},
+			toAddr,
+			nil,
+		},
+		{
+			"then runs in order",
+			first.Then(recordingRestriction(&calls, "second", nil, nil)),
+			[]string{"first", "second"},
+			toAddr,
+			nil,
+		},
+		{
+			"redirect is passed on",
+			types.ComposeSendRestrictions(
+				recordingRestriction(&calls, "redirect", otherAddr, nil),
+				func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+					calls = append(calls, "check")
+					if !toAddr.Equals(otherAddr) {
+						return toAddr, errors.New("recipient not redirected")
+					}
+					return toAddr, nil
+				},
+			),
+			[]string{"redirect", "check"},
+			otherAddr,
+			nil,
+		},
+		{
+			"error stops the chain",
+			types.ComposeSendRestrictions(
+				recordingRestriction(&calls, "reject", nil, errRejected),
+				recordingRestriction(&calls, "second", nil, nil),
+			),
+			[]string{"reject"},
+			toAddr,
+			errRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			newToAddr, err := tc.restrictor(sdk.Context{}, fromAddr, toAddr, amt)
+			require.ErrorIs(t, err, tc.expErr)
+			require.Equal(t, tc.expAddr, newToAddr)
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+}
@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeGrant(),
 		GetCmdQueryFeeGrantsByGrantee(),
 		GetCmdQueryFeeGrantsByGranter(),
+		GetCmdQuerySponsorPool(),
+		GetCmdQuerySponsorPoolUsage(),
 	)
 
 	return feegrantQueryCmd
@@ -178,3 +180,92 @@ $ %s query feegrant grants [granter]
 
 	return cmd
 }
+
+// GetCmdQuerySponsorPool returns cmd to query for the sponsor pool of a granter.
+func GetCmdQuerySponsorPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-pool [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the sponsor pool of a granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for the sponsor pool of a granter.
+
+Example:
+$ %s query feegrant sponsor-pool [granter]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SponsorPool(
+				cmd.Context(),
+				&feegrant.QuerySponsorPoolRequest{
+					Granter: granterAddr.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.SponsorPool)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySponsorPoolUsage returns cmd to query for the usage of a granter's
+// sponsor pool by a fee payer.
+func GetCmdQuerySponsorPoolUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-pool-usage [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the usage of a sponsor pool by a fee payer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the usage of a granter's sponsor pool by a fee payer in the current period.
+
+Example:
+$ %s query feegrant sponsor-pool-usage [granter] [grantee]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := feegrant.NewQueryClient(clientCtx)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SponsorPoolUsage(
+				cmd.Context(),
+				&feegrant.QuerySponsorPoolUsageRequest{
+					Granter: granterAddr.String(),
+					Grantee: granteeAddr.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Usage)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,6 +22,7 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagPeriodTxs   = "period-max-txs"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -38,6 +39,8 @@ func GetTxCmd() *cobra.Command {
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(),
 		NewCmdRevokeFeegrant(),
+		NewCmdCreateSponsorPool(),
+		NewCmdRevokeSponsorPool(),
 	)
 
 	return feegrantTxCmd
@@ -216,6 +219,130 @@ Example:
 	return cmd
 }
 
+// NewCmdCreateSponsorPool returns a CLI command handler for creating a MsgCreateSponsorPool transaction.
+func NewCmdCreateSponsorPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-sponsor-pool [granter_key_or_address]",
+		Short: "Pay fees of any account sending only allowed messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Create a sponsor pool paying fees from your address for any fee payer whose
+transaction only contains allowed messages, optionally rate limited per fee payer.
+Note, the '--from' flag is ignored as it is implied from [granter].
+
+Examples:
+%s tx %s create-sponsor-pool cosmos1skjw... --allowed-messages "/cosmos.gov.v1beta1.MsgVote" --spend-limit 1000stake
+%s tx %s create-sponsor-pool cosmos1skjw... --allowed-messages "/cosmos.gov.v1beta1.MsgVote" --period 86400 --period-limit 10stake --period-max-txs 5
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			// if `FlagSpendLimit` isn't set, limit will be nil
+			limit, err := sdk.ParseCoinsNormalized(sl)
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if exp != "" {
+				expiresAtTime, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				expiration = &expiresAtTime
+			}
+
+			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			periodLimitVal, err := cmd.Flags().GetString(FlagPeriodLimit)
+			if err != nil {
+				return err
+			}
+
+			periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+			if err != nil {
+				return err
+			}
+
+			periodTxs, err := cmd.Flags().GetUint64(FlagPeriodTxs)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgCreateSponsorPool(clientCtx.GetFromAddress(), allowedMsgs, limit, expiration,
+				getPeriod(periodClock), periodLimit, periodTxs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of messages the sponsor pool pays fees for")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the sponsor pool expires")
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max total amount the pool can spend, if not mentioned there is no limit")
+	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) of the per account rate limit window (ex: 3600)")
+	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins a single account can spend in the period")
+	cmd.Flags().Uint64(FlagPeriodTxs, 0, "period max txs specifies the maximum number of transactions a single account can have sponsored in the period")
+
+	return cmd
+}
+
+// NewCmdRevokeSponsorPool returns a CLI command handler for creating a MsgRevokeSponsorPool transaction.
+func NewCmdRevokeSponsorPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-sponsor-pool [granter]",
+		Short: "revoke sponsor pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke the sponsor pool of a granter. Note, the '--from' flag is
+			ignored as it is implied from [granter].
+
+Example:
+ $ %s tx %s revoke-sponsor-pool cosmos1skj..
+			`, version.AppName, feegrant.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokeSponsorPool(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -11,6 +11,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgCreateSponsorPool{},
+		&MsgRevokeSponsorPool{},
 	)
 
 	registry.RegisterInterface(
@@ -22,4 +22,6 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrRateLimitExceeded error if a fee payer exceeded the per account limit of a sponsor pool
+	ErrRateLimitExceeded = sdkerrors.Register(DefaultCodespace, 8, "sponsor pool rate limit exceeded")
 )
@@ -7,6 +7,10 @@ const (
 	EventTypeSetFeeGrant    = "set_feegrant"
 	EventTypeUpdateFeeGrant = "update_feegrant"
 
+	EventTypeCreateSponsorPool = "create_sponsor_pool"
+	EventTypeRevokeSponsorPool = "revoke_sponsor_pool"
+	EventTypeUseSponsorPool    = "use_sponsor_pool"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 
@@ -2,6 +2,7 @@ package feegrant
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -25,6 +26,27 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	pools := make(map[string]bool, len(data.SponsorPools))
+	for _, p := range data.SponsorPools {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+		if pools[p.Granter] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate sponsor pool for granter %s", p.Granter)
+		}
+		pools[p.Granter] = true
+	}
+
+	for _, u := range data.SponsorPoolUsages {
+		if err := u.ValidateBasic(); err != nil {
+			return err
+		}
+		if !pools[u.Granter] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "sponsor pool usage without sponsor pool for granter %s", u.Granter)
+		}
+	}
+
 	return nil
 }
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 )
@@ -45,14 +47,24 @@ func (suite *GenesisTestSuite) TestImportExportGenesis() {
 	err := suite.keeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
 	suite.Require().NoError(err)
 
+	pool := feegrant.NewSponsorPool(granteeAddr, []string{"/cosmos.bank.v1beta1.MsgSend"}, coins, &oneYear, time.Hour, coins, 0)
+	err = suite.keeper.CreateSponsorPool(suite.ctx, pool)
+	suite.Require().NoError(err)
+	err = suite.keeper.UseGrantedFees(suite.ctx, granteeAddr, granterAddr, sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10))), []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().NoError(err)
+
 	genesis, err := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().NoError(err)
+	suite.Require().Len(genesis.SponsorPools, 1)
+	suite.Require().Len(genesis.SponsorPoolUsages, 1)
 	// revoke fee allowance
 	_, err = msgSrvr.RevokeAllowance(sdk.WrapSDKContext(suite.ctx), &feegrant.MsgRevokeAllowance{
 		Granter: granterAddr.String(),
 		Grantee: granteeAddr.String(),
 	})
 	suite.Require().NoError(err)
+	err = suite.keeper.RevokeSponsorPool(suite.ctx, granteeAddr)
+	suite.Require().NoError(err)
 	err = suite.keeper.InitGenesis(suite.ctx, genesis)
 	suite.Require().NoError(err)
 
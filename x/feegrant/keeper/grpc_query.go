@@ -134,3 +134,50 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// SponsorPool returns the sponsor pool of a granter.
+func (q Keeper) SponsorPool(c context.Context, req *feegrant.QuerySponsorPoolRequest) (*feegrant.QuerySponsorPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, err := q.GetSponsorPool(ctx, granterAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return &feegrant.QuerySponsorPoolResponse{SponsorPool: pool}, nil
+}
+
+// SponsorPoolUsage returns the usage of a granter's sponsor pool by a fee payer.
+func (q Keeper) SponsorPoolUsage(c context.Context, req *feegrant.QuerySponsorPoolUsageRequest) (*feegrant.QuerySponsorPoolUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	usage, err := q.GetSponsorPoolUsage(ctx, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorPoolUsageResponse{Usage: &usage}, nil
+}
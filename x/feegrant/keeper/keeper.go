@@ -217,10 +217,15 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant feegrant.
 	return nil
 }
 
-// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee.
+// If there is no grant between the granter and the grantee, the granter's sponsor pool is used instead.
 func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	f, err := k.getGrant(ctx, granter, grantee)
 	if err != nil {
+		if _, poolErr := k.GetSponsorPool(ctx, granter); poolErr == nil {
+			return k.UseSponsorPool(ctx, granter, grantee, fee, msgs)
+		}
+
 		return err
 	}
 
@@ -285,6 +290,33 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 			return err
 		}
 	}
+
+	for _, p := range data.SponsorPools {
+		granter, err := sdk.AccAddressFromBech32(p.Granter)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setSponsorPool(ctx, granter, p); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range data.SponsorPoolUsages {
+		granter, err := sdk.AccAddressFromBech32(u.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := sdk.AccAddressFromBech32(u.Grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setSponsorPoolUsage(ctx, granter, grantee, u); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -296,9 +328,29 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*feegrant.GenesisState, error) {
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []feegrant.SponsorPool
+	err = k.IterateAllSponsorPools(ctx, func(pool feegrant.SponsorPool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.SponsorPoolUsage
+	err = k.IterateAllSponsorPoolUsages(ctx, func(usage feegrant.SponsorPoolUsage) bool {
+		usages = append(usages, usage)
+		return false
+	})
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:        grants,
+		SponsorPools:      pools,
+		SponsorPoolUsages: usages,
 	}, err
 }
 
@@ -76,3 +76,31 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
+
+// CreateSponsorPool creates a sponsor pool paying fees from the granter's funds
+// for any fee payer sending only allowed messages.
+func (k msgServer) CreateSponsorPool(goCtx context.Context, msg *feegrant.MsgCreateSponsorPool) (*feegrant.MsgCreateSponsorPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.CreateSponsorPool(ctx, msg.SponsorPool()); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgCreateSponsorPoolResponse{}, nil
+}
+
+// RevokeSponsorPool revokes the sponsor pool of a granter.
+func (k msgServer) RevokeSponsorPool(goCtx context.Context, msg *feegrant.MsgRevokeSponsorPool) (*feegrant.MsgRevokeSponsorPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeSponsorPool(ctx, granter); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeSponsorPoolResponse{}, nil
+}
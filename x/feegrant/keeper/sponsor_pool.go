@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// CreateSponsorPool creates the sponsor pool of a granter. It returns an error
+// if the granter already has a sponsor pool.
+func (k Keeper) CreateSponsorPool(ctx sdk.Context, pool feegrant.SponsorPool) error {
+	granter, err := sdk.AccAddressFromBech32(pool.Granter)
+	if err != nil {
+		return err
+	}
+
+	if pool.Expiration != nil && pool.Expiration.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	if _, err := k.GetSponsorPool(ctx, granter); err == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sponsor pool already exists")
+	}
+
+	if err := k.setSponsorPool(ctx, granter, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeCreateSponsorPool,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, pool.Granter),
+		),
+	)
+
+	return nil
+}
+
+// RevokeSponsorPool removes the sponsor pool of a granter together with the
+// usage records of all its fee payers.
+func (k Keeper) RevokeSponsorPool(ctx sdk.Context, granter sdk.AccAddress) error {
+	if _, err := k.GetSponsorPool(ctx, granter); err != nil {
+		return err
+	}
+
+	k.removeSponsorPool(ctx, granter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeRevokeSponsorPool,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetSponsorPool returns the sponsor pool of a granter.
+func (k Keeper) GetSponsorPool(ctx sdk.Context, granter sdk.AccAddress) (*feegrant.SponsorPool, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.SponsorPoolKey(granter))
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sponsor pool not found")
+	}
+
+	var pool feegrant.SponsorPool
+	if err := k.cdc.Unmarshal(bz, &pool); err != nil {
+		return nil, err
+	}
+
+	return &pool, nil
+}
+
+// GetSponsorPoolUsage returns the usage of a granter's sponsor pool by a fee
+// payer. An empty usage is returned if the fee payer never used the pool.
+func (k Keeper) GetSponsorPoolUsage(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.SponsorPoolUsage, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(feegrant.SponsorPoolUsageKey(granter, grantee))
+	if len(bz) == 0 {
+		return feegrant.SponsorPoolUsage{Granter: granter.String(), Grantee: grantee.String()}, nil
+	}
+
+	var usage feegrant.SponsorPoolUsage
+	err := k.cdc.Unmarshal(bz, &usage)
+
+	return usage, err
+}
+
+// UseSponsorPool will try to pay the given fee from the granter's sponsor pool
+// on behalf of the grantee.
+func (k Keeper) UseSponsorPool(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	pool, err := k.GetSponsorPool(ctx, granter)
+	if err != nil {
+		return err
+	}
+
+	usage, err := k.GetSponsorPoolUsage(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	remove, err := pool.Accept(ctx, &usage, fee, msgs)
+	if remove {
+		k.removeSponsorPool(ctx, granter)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !remove {
+		if err := k.setSponsorPool(ctx, granter, *pool); err != nil {
+			return err
+		}
+		if err := k.setSponsorPoolUsage(ctx, granter, grantee, usage); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeUseSponsorPool,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// IterateAllSponsorPools iterates over all the sponsor pools in the store.
+// Callback to get all data, returns true to stop, false to keep reading.
+func (k Keeper) IterateAllSponsorPools(ctx sdk.Context, cb func(pool feegrant.SponsorPool) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.SponsorPoolKeyPrefix)
+	defer iter.Close()
+
+	stop := false
+	for ; iter.Valid() && !stop; iter.Next() {
+		var pool feegrant.SponsorPool
+		if err := k.cdc.Unmarshal(iter.Value(), &pool); err != nil {
+			return err
+		}
+
+		stop = cb(pool)
+	}
+
+	return nil
+}
+
+// IterateAllSponsorPoolUsages iterates over the usage records of all sponsor
+// pools in the store. Callback to get all data, returns true to stop, false
+// to keep reading.
+func (k Keeper) IterateAllSponsorPoolUsages(ctx sdk.Context, cb func(usage feegrant.SponsorPoolUsage) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.SponsorPoolUsageKeyPrefix)
+	defer iter.Close()
+
+	stop := false
+	for ; iter.Valid() && !stop; iter.Next() {
+		var usage feegrant.SponsorPoolUsage
+		if err := k.cdc.Unmarshal(iter.Value(), &usage); err != nil {
+			return err
+		}
+
+		stop = cb(usage)
+	}
+
+	return nil
+}
+
+func (k Keeper) setSponsorPool(ctx sdk.Context, granter sdk.AccAddress, pool feegrant.SponsorPool) error {
+	bz, err := k.cdc.Marshal(&pool)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(feegrant.SponsorPoolKey(granter), bz)
+	return nil
+}
+
+func (k Keeper) setSponsorPoolUsage(ctx sdk.Context, granter, grantee sdk.AccAddress, usage feegrant.SponsorPoolUsage) error {
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(feegrant.SponsorPoolUsageKey(granter, grantee), bz)
+	return nil
+}
+
+// removeSponsorPool deletes the sponsor pool of a granter and all its usage
+// records.
+func (k Keeper) removeSponsorPool(ctx sdk.Context, granter sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(feegrant.SponsorPoolKey(granter))
+
+	iter := sdk.KVStorePrefixIterator(store, feegrant.SponsorPoolUsagePrefixByGranter(granter))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
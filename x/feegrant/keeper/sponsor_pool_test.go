@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func (suite *KeeperTestSuite) TestSponsorPool() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	allowed := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	msgs := []sdk.Msg{&banktypes.MsgSend{}}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 200))
+
+	msg := feegrant.NewMsgCreateSponsorPool(granter, allowed, suite.atom, nil, time.Hour, fee, 0)
+	_, err := suite.msgSrvr.CreateSponsorPool(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// a granter can only have one sponsor pool
+	_, err = suite.msgSrvr.CreateSponsorPool(suite.ctx, msg)
+	suite.Require().Error(err)
+
+	pool, err := suite.keeper.GetSponsorPool(suite.sdkCtx, granter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(msg.SponsorPool(), *pool)
+
+	// the pool is used when there is no direct grant
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee, fee, msgs)
+	suite.Require().NoError(err)
+
+	pool, err = suite.keeper.GetSponsorPool(suite.sdkCtx, granter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.atom.Sub(fee), pool.SpendLimit)
+
+	usage, err := suite.keeper.GetSponsorPoolUsage(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fee, usage.PeriodSpent)
+	suite.Require().Equal(uint64(1), usage.PeriodTxs)
+
+	// the grantee is rate limited, other fee payers are not
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee, fee, msgs)
+	suite.Require().ErrorIs(err, feegrant.ErrRateLimitExceeded)
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, suite.addrs[2], fee, msgs)
+	suite.Require().NoError(err)
+
+	// the rate limit is lifted in the next period
+	ctx := suite.sdkCtx.WithBlockTime(suite.sdkCtx.BlockTime().Add(2 * time.Hour))
+	err = suite.keeper.UseGrantedFees(ctx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), msgs)
+	suite.Require().NoError(err)
+
+	// the pool spend limit is exceeded
+	err = suite.keeper.UseGrantedFees(ctx, granter, suite.addrs[3], fee, msgs)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	// a direct grant takes precedence over the sponsor pool
+	err = suite.keeper.GrantAllowance(ctx, granter, suite.addrs[3], &feegrant.BasicAllowance{SpendLimit: suite.atom})
+	suite.Require().NoError(err)
+	err = suite.keeper.UseGrantedFees(ctx, granter, suite.addrs[3], fee, msgs)
+	suite.Require().NoError(err)
+
+	// the pool is removed together with its usage when revoked
+	_, err = suite.msgSrvr.RevokeSponsorPool(sdk.WrapSDKContext(ctx), &feegrant.MsgRevokeSponsorPool{Granter: granter.String()})
+	suite.Require().NoError(err)
+	_, err = suite.keeper.GetSponsorPool(ctx, granter)
+	suite.Require().Error(err)
+	usage, err = suite.keeper.GetSponsorPoolUsage(ctx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Zero(usage.PeriodTxs)
+
+	err = suite.keeper.UseGrantedFees(ctx, granter, grantee, fee, msgs)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestSponsorPoolExpired() {
+	granter := suite.addrs[0]
+	allowed := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	exp := suite.sdkCtx.BlockTime().Add(time.Hour)
+
+	past := suite.sdkCtx.BlockTime().Add(-time.Hour)
+	err := suite.keeper.CreateSponsorPool(suite.sdkCtx, feegrant.NewSponsorPool(granter, allowed, nil, &past, 0, nil, 0))
+	suite.Require().Error(err)
+
+	err = suite.keeper.CreateSponsorPool(suite.sdkCtx, feegrant.NewSponsorPool(granter, allowed, nil, &exp, 0, nil, 0))
+	suite.Require().NoError(err)
+
+	ctx := suite.sdkCtx.WithBlockTime(exp.Add(time.Second))
+	err = suite.keeper.UseGrantedFees(ctx, granter, suite.addrs[1], suite.atom, []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExpired)
+
+	_, err = suite.keeper.GetSponsorPool(ctx, granter)
+	suite.Require().Error(err)
+}
@@ -30,6 +30,14 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+
+	// SponsorPoolKeyPrefix is the set of the kvstore for sponsor pool data
+	// - 0x02<granter_address_len (1 Byte)><granter_address_Bytes>: sponsor pool
+	SponsorPoolKeyPrefix = []byte{0x02}
+
+	// SponsorPoolUsageKeyPrefix is the set of the kvstore for sponsor pool usage data
+	// - 0x03<sponsor_pool_usage_key_bytes>: sponsor pool usage
+	SponsorPoolUsageKeyPrefix = []byte{0x03}
 )
 
 // FeeAllowanceKey is the canonical key to store a grant from granter to grantee
@@ -81,3 +89,29 @@ func ParseAddressesFromFeeAllowanceKey(key []byte) (granter, grantee sdk.AccAddr
 
 	return granter, grantee
 }
+
+// SponsorPoolKey is the canonical key to store the sponsor pool of a granter.
+//
+// Key format:
+// - <0x02><len(granter_address_bytes)><granter_address_bytes>
+func SponsorPoolKey(granter sdk.AccAddress) []byte {
+	return append(SponsorPoolKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
+// SponsorPoolUsagePrefixByGranter returns a prefix to scan for the usage of a
+// granter's sponsor pool by all fee payers.
+//
+// Key format:
+// - <0x03><len(granter_address_bytes)><granter_address_bytes>
+func SponsorPoolUsagePrefixByGranter(granter sdk.AccAddress) []byte {
+	return append(SponsorPoolUsageKeyPrefix, address.MustLengthPrefix(granter.Bytes())...)
+}
+
+// SponsorPoolUsageKey is the canonical key to store the usage of a granter's
+// sponsor pool by a fee payer.
+//
+// Key format:
+// - <0x03><len(granter_address_bytes)><granter_address_bytes><len(grantee_address_bytes)><grantee_address_bytes>
+func SponsorPoolUsageKey(granter, grantee sdk.AccAddress) []byte {
+	return append(SponsorPoolUsagePrefixByGranter(granter), address.MustLengthPrefix(grantee.Bytes())...)
+}
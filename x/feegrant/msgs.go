@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
@@ -13,6 +15,8 @@ import (
 var (
 	_, _ sdk.Msg            = &MsgGrantAllowance{}, &MsgRevokeAllowance{}
 	_, _ legacytx.LegacyMsg = &MsgGrantAllowance{}, &MsgRevokeAllowance{} // For amino support.
+	_, _ sdk.Msg            = &MsgCreateSponsorPool{}, &MsgRevokeSponsorPool{}
+	_, _ legacytx.LegacyMsg = &MsgCreateSponsorPool{}, &MsgRevokeSponsorPool{} // For amino support.
 
 	_ types.UnpackInterfacesMessage = &MsgGrantAllowance{}
 )
@@ -135,3 +139,95 @@ func (msg MsgRevokeAllowance) Route() string {
 func (msg MsgRevokeAllowance) GetSignBytes() []byte {
 	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgCreateSponsorPool returns a message to create a sponsor pool paying
+// fees from the granter's account.
+//nolint:interfacer
+func NewMsgCreateSponsorPool(granter sdk.AccAddress, allowedMsgs []string, spendLimit sdk.Coins, expiration *time.Time,
+	period time.Duration, periodSpendLimit sdk.Coins, periodMaxTxs uint64) *MsgCreateSponsorPool {
+	return &MsgCreateSponsorPool{
+		Granter:          granter.String(),
+		AllowedMessages:  allowedMsgs,
+		SpendLimit:       spendLimit,
+		Expiration:       expiration,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodMaxTxs:     periodMaxTxs,
+	}
+}
+
+// SponsorPool returns the sponsor pool described by the message.
+func (msg MsgCreateSponsorPool) SponsorPool() SponsorPool {
+	return SponsorPool{
+		Granter:          msg.Granter,
+		AllowedMessages:  msg.AllowedMessages,
+		SpendLimit:       msg.SpendLimit,
+		Expiration:       msg.Expiration,
+		Period:           msg.Period,
+		PeriodSpendLimit: msg.PeriodSpendLimit,
+		PeriodMaxTxs:     msg.PeriodMaxTxs,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateSponsorPool) ValidateBasic() error {
+	return msg.SponsorPool().ValidateBasic()
+}
+
+// GetSigners gets the granter account funding the sponsor pool.
+func (msg MsgCreateSponsorPool) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateSponsorPool) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateSponsorPool) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCreateSponsorPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRevokeSponsorPool returns a message to revoke the sponsor pool of a
+// granter.
+//nolint:interfacer
+func NewMsgRevokeSponsorPool(granter sdk.AccAddress) MsgRevokeSponsorPool {
+	return MsgRevokeSponsorPool{Granter: granter.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeSponsorPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners gets the granter account owning the sponsor pool.
+func (msg MsgRevokeSponsorPool) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRevokeSponsorPool) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRevokeSponsorPool) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRevokeSponsorPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,154 @@
+package feegrant
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewSponsorPool creates a new SponsorPool paying fees from the granter's account.
+//nolint:interfacer
+func NewSponsorPool(granter sdk.AccAddress, allowedMsgs []string, spendLimit sdk.Coins, expiration *time.Time,
+	period time.Duration, periodSpendLimit sdk.Coins, periodMaxTxs uint64) SponsorPool {
+	return SponsorPool{
+		Granter:          granter.String(),
+		AllowedMessages:  allowedMsgs,
+		SpendLimit:       spendLimit,
+		Expiration:       expiration,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodMaxTxs:     periodMaxTxs,
+	}
+}
+
+// ValidateBasic enforces basic sanity checks on the sponsor pool.
+func (p SponsorPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if len(p.AllowedMessages) == 0 {
+		return sdkerrors.Wrap(ErrNoMessages, "allowed messages shouldn't be empty")
+	}
+	if p.SpendLimit != nil {
+		if !p.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", p.SpendLimit)
+		}
+		if !p.SpendLimit.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+		}
+	}
+	if p.Expiration != nil && p.Expiration.Unix() < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+	if p.Period < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "negative clock step")
+	}
+	if p.PeriodSpendLimit != nil {
+		if !p.PeriodSpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period spend limit is invalid: %s", p.PeriodSpendLimit)
+		}
+		if !p.PeriodSpendLimit.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "period spend limit must be positive")
+		}
+	}
+
+	hasRateLimit := !p.PeriodSpendLimit.Empty() || p.PeriodMaxTxs > 0
+	if hasRateLimit && p.Period == 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "period must be set when a per account limit is set")
+	}
+	if !hasRateLimit && p.Period != 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "period is set without any per account limit")
+	}
+
+	return nil
+}
+
+// Accept checks whether the sponsor pool can pay the given fee for the fee
+// payer whose usage is passed in, and updates both the pool and the usage
+// accordingly. It follows the same conventions as FeeAllowanceI.Accept: if
+// remove is true (regardless of the error), the pool must be deleted from
+// storage.
+func (p *SponsorPool) Accept(ctx sdk.Context, usage *SponsorPoolUsage, fee sdk.Coins, msgs []sdk.Msg) (remove bool, err error) {
+	blockTime := ctx.BlockTime()
+	if p.Expiration != nil && p.Expiration.Before(blockTime) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "sponsor pool")
+	}
+
+	if !p.allMsgTypesAllowed(ctx, msgs) {
+		return false, sdkerrors.Wrap(ErrMessageNotAllowed, "message does not exist in sponsor pool allowed messages")
+	}
+
+	if p.Period > 0 {
+		usage.tryResetPeriod(blockTime, p.Period)
+
+		if p.PeriodMaxTxs > 0 && usage.PeriodTxs >= p.PeriodMaxTxs {
+			return false, sdkerrors.Wrapf(ErrRateLimitExceeded, "at most %d transactions per period", p.PeriodMaxTxs)
+		}
+
+		spent := usage.PeriodSpent.Add(fee...)
+		if !p.PeriodSpendLimit.Empty() && !spent.IsAllLTE(p.PeriodSpendLimit) {
+			return false, sdkerrors.Wrapf(ErrRateLimitExceeded, "at most %s per period", p.PeriodSpendLimit)
+		}
+
+		usage.PeriodSpent = spent
+		usage.PeriodTxs++
+	}
+
+	if p.SpendLimit != nil {
+		left, invalid := p.SpendLimit.SafeSub(fee)
+		if invalid {
+			return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "sponsor pool")
+		}
+
+		p.SpendLimit = left
+		return left.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+func (p SponsorPool) allMsgTypesAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
+	msgsMap := make(map[string]bool, len(p.AllowedMessages))
+	for _, msg := range p.AllowedMessages {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		msgsMap[msg] = true
+	}
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		if !msgsMap[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tryResetPeriod starts a new period if the current one is over. The new
+// period starts at the block time of the first transaction after the previous
+// period ended.
+func (u *SponsorPoolUsage) tryResetPeriod(blockTime time.Time, period time.Duration) {
+	if blockTime.Before(u.PeriodReset) {
+		return
+	}
+
+	u.PeriodSpent = nil
+	u.PeriodTxs = 0
+	u.PeriodReset = blockTime.Add(period)
+}
+
+// ValidateBasic enforces basic sanity checks on the sponsor pool usage.
+func (u SponsorPoolUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if !u.PeriodSpent.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period spent is invalid: %s", u.PeriodSpent)
+	}
+
+	return nil
+}
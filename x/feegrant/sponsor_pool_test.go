@@ -0,0 +1,223 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestSponsorPoolValidateBasic(t *testing.T) {
+	_, _, granter := testdata.KeyTestPubAddr()
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	msgs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+	cases := map[string]struct {
+		pool  feegrant.SponsorPool
+		valid bool
+	}{
+		"empty": {
+			pool:  feegrant.SponsorPool{},
+			valid: false,
+		},
+		"no allowed messages": {
+			pool:  feegrant.NewSponsorPool(granter, nil, atom, nil, 0, nil, 0),
+			valid: false,
+		},
+		"unlimited": {
+			pool:  feegrant.NewSponsorPool(granter, msgs, nil, nil, 0, nil, 0),
+			valid: true,
+		},
+		"rate limited": {
+			pool:  feegrant.NewSponsorPool(granter, msgs, atom, nil, time.Hour, atom, 3),
+			valid: true,
+		},
+		"rate limit without period": {
+			pool:  feegrant.NewSponsorPool(granter, msgs, atom, nil, 0, nil, 3),
+			valid: false,
+		},
+		"period without rate limit": {
+			pool:  feegrant.NewSponsorPool(granter, msgs, atom, nil, time.Hour, nil, 0),
+			valid: false,
+		},
+		"negative period": {
+			pool:  feegrant.NewSponsorPool(granter, msgs, atom, nil, -time.Hour, atom, 0),
+			valid: false,
+		},
+		"zero spend limit": {
+			pool:  feegrant.NewSponsorPool(granter, msgs, sdk.Coins{sdk.NewInt64Coin("atom", 0)}, nil, 0, nil, 0),
+			valid: false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.pool.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSponsorPoolAccept(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+
+	now := ctx.BlockTime()
+	oneHour := now.Add(1 * time.Hour)
+	send := []sdk.Msg{&banktypes.MsgSend{}}
+	multiSend := []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}}
+	allowed := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+	cases := map[string]struct {
+		pool      feegrant.SponsorPool
+		usage     feegrant.SponsorPoolUsage
+		fee       sdk.Coins
+		msgs      []sdk.Msg
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+		spent     sdk.Coins
+		txs       uint64
+		resetsAt  time.Time
+	}{
+		"no limits": {
+			pool:   feegrant.NewSponsorPool(granter, allowed, nil, nil, 0, nil, 0),
+			fee:    atom,
+			msgs:   send,
+			accept: true,
+		},
+		"message not allowed": {
+			pool:   feegrant.NewSponsorPool(granter, allowed, nil, nil, 0, nil, 0),
+			fee:    smallAtom,
+			msgs:   multiSend,
+			accept: false,
+		},
+		"expired": {
+			pool:      feegrant.NewSponsorPool(granter, allowed, atom, &now, 0, nil, 0),
+			fee:       smallAtom,
+			msgs:      send,
+			blockTime: oneHour,
+			accept:    false,
+			remove:    true,
+		},
+		"spend limit exceeded": {
+			pool:   feegrant.NewSponsorPool(granter, allowed, smallAtom, nil, 0, nil, 0),
+			fee:    atom,
+			msgs:   send,
+			accept: false,
+		},
+		"spend limit used up": {
+			pool:   feegrant.NewSponsorPool(granter, allowed, atom, nil, 0, nil, 0),
+			fee:    atom,
+			msgs:   send,
+			accept: true,
+			remove: true,
+		},
+		"first use starts a period": {
+			pool:     feegrant.NewSponsorPool(granter, allowed, atom, nil, time.Hour, atom, 2),
+			fee:      smallAtom,
+			msgs:     send,
+			accept:   true,
+			remains:  atom.Sub(smallAtom),
+			spent:    smallAtom,
+			txs:      1,
+			resetsAt: oneHour,
+		},
+		"period spend limit exceeded": {
+			pool: feegrant.NewSponsorPool(granter, allowed, nil, nil, time.Hour, atom, 0),
+			usage: feegrant.SponsorPoolUsage{
+				PeriodReset: oneHour,
+				PeriodSpent: atom.Sub(smallAtom).Add(sdk.NewInt64Coin("atom", 1)),
+			},
+			fee:    smallAtom,
+			msgs:   send,
+			accept: false,
+		},
+		"period spend limit in other denom": {
+			pool:   feegrant.NewSponsorPool(granter, allowed, nil, nil, time.Hour, atom, 0),
+			fee:    eth,
+			msgs:   send,
+			accept: false,
+		},
+		"period max txs exceeded": {
+			pool: feegrant.NewSponsorPool(granter, allowed, nil, nil, time.Hour, nil, 2),
+			usage: feegrant.SponsorPoolUsage{
+				PeriodReset: oneHour,
+				PeriodTxs:   2,
+			},
+			fee:    smallAtom,
+			msgs:   send,
+			accept: false,
+		},
+		"period reset": {
+			pool: feegrant.NewSponsorPool(granter, allowed, nil, nil, time.Hour, smallAtom, 2),
+			usage: feegrant.SponsorPoolUsage{
+				PeriodReset: now,
+				PeriodSpent: smallAtom,
+				PeriodTxs:   2,
+			},
+			fee:       smallAtom,
+			msgs:      send,
+			blockTime: now.Add(time.Minute),
+			accept:    true,
+			spent:     smallAtom,
+			txs:       1,
+			resetsAt:  now.Add(time.Minute + time.Hour),
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, tc.pool.ValidateBasic())
+
+			blockTime := tc.blockTime
+			if blockTime.IsZero() {
+				blockTime = now
+			}
+			ctx := ctx.WithBlockTime(blockTime)
+
+			usage := tc.usage
+			usage.Granter, usage.Grantee = granter.String(), grantee.String()
+
+			remove, err := tc.pool.Accept(ctx, &usage, tc.fee, tc.msgs)
+			require.Equal(t, tc.remove, remove)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.remains != nil {
+				require.Equal(t, tc.remains, tc.pool.SpendLimit)
+			}
+			if tc.pool.Period > 0 {
+				require.Equal(t, tc.spent, usage.PeriodSpent)
+				require.Equal(t, tc.txs, usage.PeriodTxs)
+				require.True(t, tc.resetsAt.Equal(usage.PeriodReset))
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).
+		WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 1000}})
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.OneDec())
+
+	// a full block raises the base fee by 1 / BaseFeeChangeDenominator
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000)).WithEventManager(sdk.NewEventManager())
+	ctx.BlockGasMeter().ConsumeGas(1000, "txs")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeBaseFee, events[0].Type)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3).String(), string(events[0].Attributes[0].Value))
+	require.Equal(t, "1000", string(events[0].Attributes[1].Value))
+
+	// an empty block lowers it
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000))
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(984375, 6), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// the base fee is unchanged without a max block gas
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: -1}})
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(984375, 6), app.FeeMarketKeeper.GetBaseFee(ctx))
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	paramSpace       paramtypes.Subspace
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, feeCollectorName string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the feemarket module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTableWithAccounts(ak))
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetBaseFee returns the base fee of the current block, as a gas price.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		panic("stored base fee should not have been nil")
+	}
+
+	var baseFee sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &baseFee)
+	return baseFee.Dec
+}
+
+// SetBaseFee sets the base fee of the current block, as a gas price.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: baseFee})
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters. It panics if the base
+// fee recipient is not a module account.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	if err := types.ValidateBaseFeeRecipientAccount(k.accountKeeper, params.BaseFeeRecipient); err != nil {
+		panic(err)
+	}
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// RequiredFee returns the base fee a tx with a gas limit pays in the current
+// block.
+func (k Keeper) RequiredFee(ctx sdk.Context, gasLimit uint64) sdk.Coin {
+	return k.GetParams(ctx).RequiredFee(k.GetBaseFee(ctx), gasLimit)
+}
+
+// CollectBaseFee sends the base fee of a tx, which was collected by the fee
+// collector, to the base fee recipient, or burns it if there is none.
+func (k Keeper) CollectBaseFee(ctx sdk.Context, baseFee sdk.Coin) error {
+	if baseFee.IsZero() {
+		return nil
+	}
+
+	fee := sdk.NewCoins(baseFee)
+	if recipient := k.GetParams(ctx).BaseFeeRecipient; recipient != "" {
+		if err := types.ValidateBaseFeeRecipientAccount(k.accountKeeper, recipient); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownAddress, err.Error())
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, recipient, fee)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fee); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+
+	suite.queryClient = queryClient
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.Params, app.FeeMarketKeeper.GetParams(ctx))
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 2))
+	baseFee, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 2)), baseFee.BaseFee)
+}
+
+func (suite *KeeperTestSuite) TestRequiredFee() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), app.FeeMarketKeeper.RequiredFee(ctx, 1000))
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 2))
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250), app.FeeMarketKeeper.RequiredFee(ctx, 1000))
+}
+
+func (suite *KeeperTestSuite) TestCollectBaseFee() {
+	app, ctx := suite.app, suite.ctx
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	distrAddr := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	distrBalance := app.BankKeeper.GetBalance(ctx, distrAddr, sdk.DefaultBondDenom)
+
+	// a zero base fee is a no-op
+	suite.Require().NoError(app.FeeMarketKeeper.CollectBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)))
+	suite.Require().Equal(fees, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	// the base fee is burnt without a recipient
+	baseFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)
+	suite.Require().NoError(app.FeeMarketKeeper.CollectBaseFee(ctx, baseFee))
+	suite.Require().Equal(fees.Sub(sdk.NewCoins(baseFee)), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	suite.Require().Equal(supply.Sub(baseFee), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	// the base fee is sent to the recipient if there is one
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.BaseFeeRecipient = distrtypes.ModuleName
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	suite.Require().NoError(app.FeeMarketKeeper.CollectBaseFee(ctx, baseFee))
+	suite.Require().Equal(fees.Sub(sdk.NewCoins(baseFee.Add(baseFee))), app.BankKeeper.GetAllBalances(ctx, feeCollector))
+	suite.Require().Equal(supply.Sub(baseFee), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	suite.Require().Equal(distrBalance.Add(baseFee), app.BankKeeper.GetBalance(ctx, distrAddr, sdk.DefaultBondDenom))
+
+	// the fee collector must hold the base fee
+	suite.Require().Error(app.FeeMarketKeeper.CollectBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeRecipientAccount() {
+	app, ctx := suite.app, suite.ctx
+	subspace := app.GetSubspace(types.ModuleName)
+
+	// the recipient must be a module account
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.BaseFeeRecipient = "unknown"
+	suite.Require().Panics(func() { app.FeeMarketKeeper.SetParams(ctx, params) })
+	suite.Require().Error(subspace.Update(ctx, types.KeyBaseFeeRecipient, []byte(`"unknown"`)))
+	suite.Require().NoError(subspace.Update(ctx, types.KeyBaseFeeRecipient, []byte(`"distribution"`)))
+	suite.Require().Equal(distrtypes.ModuleName, app.FeeMarketKeeper.GetParams(ctx).BaseFeeRecipient)
+
+	// base fees are not collected by an unknown recipient set without validation
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+	subspace.Set(ctx, types.KeyBaseFeeRecipient, "unknown")
+	suite.Require().Error(app.FeeMarketKeeper.CollectBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the block following a block which used
+// gasUsed of its maximum gas, given the base fee of that block. The base fee
+// changes proportionally to the difference between the gas used and the target
+// gas, which is maxBlockGas / ElasticityMultiplier, and is then bounded by the
+// min and max base fees. It only changes by these bounds for blocks with no
+// maximum gas.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed, maxBlockGas uint64) sdk.Dec {
+	if targetGas := maxBlockGas / uint64(p.ElasticityMultiplier); targetGas > 0 {
+		target := sdk.NewDecFromInt(sdk.NewIntFromUint64(targetGas))
+		used := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+
+		// baseFee * (gasUsed - targetGas) / targetGas / BaseFeeChangeDenominator
+		delta := baseFee.Mul(used.Sub(target)).Quo(target).QuoInt64(int64(p.BaseFeeChangeDenominator))
+		baseFee = baseFee.Add(delta)
+	}
+
+	if baseFee.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+	if p.MaxBaseFee.IsPositive() && baseFee.GT(p.MaxBaseFee) {
+		return p.MaxBaseFee
+	}
+
+	return baseFee
+}
+
+// RequiredFee returns the base fee portion of the fee of a tx with a gas limit,
+// which is baseFee * gasLimit, rounded up.
+func (p Params) RequiredFee(baseFee sdk.Dec, gasLimit uint64) sdk.Coin {
+	amount := baseFee.MulInt(sdk.NewIntFromUint64(gasLimit)).Ceil().RoundInt()
+	return sdk.NewCoin(p.Denom, amount)
+}
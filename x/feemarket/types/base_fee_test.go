@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	params.MaxBaseFee = sdk.NewDec(10)
+	baseFee := sdk.OneDec()
+
+	testCases := []struct {
+		name        string
+		baseFee     sdk.Dec
+		gasUsed     uint64
+		maxBlockGas uint64
+		expBaseFee  sdk.Dec
+	}{
+		{"target gas used", baseFee, 500, 1000, baseFee},
+		{"full block", baseFee, 1000, 1000, sdk.NewDecWithPrec(1125, 3)},
+		{"empty block", baseFee, 0, 1000, sdk.NewDecWithPrec(875, 3)},
+		{"more gas used than the max", baseFee, 1500, 1000, sdk.NewDecWithPrec(125, 2)},
+		{"no max block gas", baseFee, 1000, 0, baseFee},
+		{"bounded by min base fee", sdk.NewDecWithPrec(1, 2), 0, 1000, sdk.NewDecWithPrec(1, 2)},
+		{"bounded by max base fee", sdk.NewDec(10), 1000, 1000, sdk.NewDec(10)},
+		{"below min base fee without max block gas", sdk.ZeroDec(), 0, 0, sdk.NewDecWithPrec(1, 2)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expBaseFee, params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.maxBlockGas))
+		})
+	}
+
+	// a zero max base fee does not bound the base fee
+	params.MaxBaseFee = sdk.ZeroDec()
+	require.Equal(t, sdk.NewDecWithPrec(1125, 2), params.NextBaseFee(sdk.NewDec(10), 1000, 1000))
+}
+
+func TestRequiredFee(t *testing.T) {
+	params := types.DefaultParams()
+
+	require.Equal(t, sdk.NewInt64Coin(params.Denom, 0), params.RequiredFee(sdk.ZeroDec(), 1000))
+	require.Equal(t, sdk.NewInt64Coin(params.Denom, 2000), params.RequiredFee(sdk.NewDec(2), 1000))
+	// the required fee is rounded up
+	require.Equal(t, sdk.NewInt64Coin(params.Denom, 1), params.RequiredFee(sdk.NewDecWithPrec(1, 3), 1))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	invalidParams := types.DefaultParams()
+	invalidParams.ElasticityMultiplier = 0
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(invalidParams, sdk.ZeroDec())))
+
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), sdk.NewDec(-1))))
+}
@@ -0,0 +1,12 @@
+package types
+
+// BaseFeeKey is the key of the base fee in the keeper store.
+var BaseFeeKey = []byte{0x00}
+
+const (
+	// ModuleName is the name of the feemarket module
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+)
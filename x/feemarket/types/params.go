@@ -0,0 +1,211 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyDenom                    = []byte("Denom")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyMaxBaseFee               = []byte("MaxBaseFee")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyBaseFeeRecipient         = []byte("BaseFeeRecipient")
+)
+
+// ParamKeyTable for feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamKeyTableWithAccounts is the ParamKeyTable of the feemarket module, which
+// also rejects param changes setting a base fee recipient which is not a module
+// account of the account keeper.
+func ParamKeyTableWithAccounts(ak AccountKeeper) paramtypes.KeyTable {
+	keyTable := paramtypes.NewKeyTable()
+	for _, psp := range (&Params{}).ParamSetPairs() {
+		if bytes.Equal(psp.Key, KeyBaseFeeRecipient) {
+			psp.ValidatorFn = func(i interface{}) error {
+				if err := validateBaseFeeRecipient(i); err != nil {
+					return err
+				}
+				return ValidateBaseFeeRecipientAccount(ak, i.(string))
+			}
+		}
+		keyTable = keyTable.RegisterType(psp)
+	}
+	return keyTable
+}
+
+func NewParams(
+	denom string, minBaseFee, maxBaseFee sdk.Dec, baseFeeChangeDenominator, elasticityMultiplier uint32,
+	baseFeeRecipient string,
+) Params {
+	return Params{
+		Denom:                    denom,
+		MinBaseFee:               minBaseFee,
+		MaxBaseFee:               maxBaseFee,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		BaseFeeRecipient:         baseFeeRecipient,
+	}
+}
+
+// DefaultParams returns the default feemarket module parameters. The minimum
+// base fee is zero, so the base fee is zero unless a genesis base fee is set.
+func DefaultParams() Params {
+	return Params{
+		Denom:                    sdk.DefaultBondDenom,
+		MinBaseFee:               sdk.ZeroDec(),
+		MaxBaseFee:               sdk.ZeroDec(),
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+		BaseFeeRecipient:         "",
+	}
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	if err := validateDenom(p.Denom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateMaxBaseFee(p.MaxBaseFee); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+	if err := validateBaseFeeRecipient(p.BaseFeeRecipient); err != nil {
+		return err
+	}
+	if p.MaxBaseFee.IsPositive() && p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf(
+			"max base fee (%s) must be greater than or equal to min base fee (%s)",
+			p.MaxBaseFee, p.MinBaseFee,
+		)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenom, &p.Denom, validateDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateMaxBaseFee),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyBaseFeeRecipient, &p.BaseFeeRecipient, validateBaseFeeRecipient),
+	}
+}
+
+func validateDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("base fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be nil or negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max base fee cannot be nil or negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base fee change denominator must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("elasticity multiplier must be positive: %d", v)
+	}
+
+	return nil
+}
+
+// ValidateBaseFeeRecipientAccount returns an error if a base fee recipient is
+// not a module account of the account keeper, which base fees could not be sent
+// to. An empty recipient is valid, as base fees are then burnt.
+func ValidateBaseFeeRecipientAccount(ak AccountKeeper, recipient string) error {
+	if recipient != "" && ak.GetModuleAddress(recipient) == nil {
+		return fmt.Errorf("base fee recipient is not a module account: %s", recipient)
+	}
+
+	return nil
+}
+
+func validateBaseFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("base fee recipient cannot have leading or trailing spaces: %q", v)
+	}
+
+	return nil
+}
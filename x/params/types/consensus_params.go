@@ -4,6 +4,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
 // ConsensusParamsKeyTable returns an x/params module keyTable to be used in
@@ -22,5 +23,8 @@ func ConsensusParamsKeyTable() KeyTable {
 		NewParamSetPair(
 			baseapp.ParamStoreKeyValidatorParams, tmproto.ValidatorParams{}, baseapp.ValidateValidatorParams,
 		),
+		NewParamSetPair(
+			baseapp.ParamStoreKeyGasSchedule, storetypes.GasSchedule{}, baseapp.ValidateGasSchedule,
+		),
 	)
 }
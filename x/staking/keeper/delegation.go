@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -950,3 +951,132 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves up to wantAmt tokens from the unbonding entries of
+// the delegator fromAddr with validator valAddr to the delegator toAddr. The
+// transferred entries keep their creation height and completion time. It
+// returns the amount actually transferred, which can be less than wantAmt if
+// there are not enough tokens unbonding, or an error if the recipient would
+// exceed the maximum number of unbonding entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) (sdk.Int, error) {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	ubdFromModified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			return sdk.ZeroInt(), types.ErrMaxUnbondingDelegationEntries
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		ubdFromModified = true
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+
+		entry.Balance = entry.Balance.Sub(toXfer)
+		entry.InitialBalance = entry.InitialBalance.Sub(toXfer)
+		if entry.Balance.IsZero() {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+		} else {
+			ubdFrom.Entries[i] = entry
+		}
+	}
+
+	if !ubdFromModified {
+		return transferred, nil
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries;
+	// the remaining unbonding queue entries of the sender are harmless since
+	// CompleteUnbonding is skipped for missing unbonding delegations
+	if len(ubdFrom.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubdFrom)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubdFrom)
+	}
+
+	return transferred, nil
+}
+
+// TransferDelegation moves up to wantShares delegation shares with validator
+// valAddr from the delegator fromAddr to the delegator toAddr. Shares which
+// were received by a redelegation that is still in progress are not
+// transferred, since a slash of the redelegation source validator must still
+// be applied to the original delegator. It returns the shares actually
+// transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), nil
+	}
+
+	// compute the shares which are not part of an ongoing redelegation
+	transferable := delFrom.Shares
+	for _, red := range k.GetRedelegations(ctx, fromAddr, math.MaxUint16) {
+		if red.ValidatorDstAddress != valAddr.String() {
+			continue
+		}
+
+		for _, entry := range red.Entries {
+			transferable = transferable.Sub(entry.SharesDst)
+		}
+	}
+
+	shares := sdk.MinDec(transferable, wantShares)
+	if !shares.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		if err := k.BeforeDelegationSharesModified(ctx, toAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		if err := k.BeforeDelegationCreated(ctx, toAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+	}
+
+	delFrom.Shares = delFrom.Shares.Sub(shares)
+	if delFrom.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, delFrom); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		if err := k.AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	delTo.Shares = delTo.Shares.Add(shares)
+	k.SetDelegation(ctx, delTo)
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return shares, nil
+}
@@ -991,3 +991,95 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valAddr := validator.GetOperator()
+
+	shares, err := app.StakingKeeper.Delegate(ctx, addrDels[0], sdk.NewInt(100), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// transfer half of the shares to a new delegator
+	half := shares.QuoInt64(2)
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], valAddr, half)
+	require.NoError(t, err)
+	require.Equal(t, half, transferred)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddr)
+	require.True(t, found)
+	require.Equal(t, half, delegation.Shares)
+
+	// cannot transfer more shares than delegated
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], valAddr, shares)
+	require.NoError(t, err)
+	require.Equal(t, shares.Sub(half), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddr)
+	require.False(t, found)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddr)
+	require.True(t, found)
+	require.Equal(t, shares, delegation.Shares)
+
+	// nothing is transferred without a delegation
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], valAddr, shares)
+	require.NoError(t, err)
+	require.True(t, transferred.IsZero())
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	ubd := app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], valAddr, 0, completionTime, sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], valAddr, 0, completionTime, sdk.NewInt(20))
+	app.StakingKeeper.InsertUBDQueue(ctx, ubd, completionTime)
+
+	// the entries are transferred in order, splitting the last one
+	transferred, err := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddr, sdk.NewInt(15))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(15), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddr)
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, sdk.NewInt(15), ubdFrom.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(15), ubdFrom.Entries[0].InitialBalance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], valAddr)
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(10), ubdTo.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[1].Balance)
+	require.True(t, completionTime.Equal(ubdTo.Entries[1].CompletionTime))
+
+	// cannot transfer more than unbonding
+	transferred, err = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddr, sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(15), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddr)
+	require.False(t, found)
+}
+
+func TestTransferUnbondingMaxEntries(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], valAddr, 0, completionTime, sdk.NewInt(10))
+	maxEntries := app.StakingKeeper.MaxEntries(ctx)
+	for i := uint32(0); i < maxEntries; i++ {
+		app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[1], valAddr, int64(i), completionTime, sdk.NewInt(1))
+	}
+
+	// the recipient cannot receive more unbonding entries
+	_, err := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddr, sdk.NewInt(5))
+	require.ErrorIs(t, err, types.ErrMaxUnbondingDelegationEntries)
+}